@@ -0,0 +1,100 @@
+package validator
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+var (
+	// aliases - relation between the names of the aliases and their rules, added with RegisterAlias
+	aliases = make(map[string]string)
+	// tagsRules - cache of the rules of each tag, with the aliases expanded
+	tagsRules sync.Map
+	// structsFields - cache of the data of the fields of each struct type, see getStructFields
+	structsFields sync.Map
+)
+
+// aliasRule - rule of a tag with the aliases expanded, Alias is the name of the alias used in the tag
+type aliasRule struct {
+	TagRule
+	Alias string
+}
+
+// RegisterAlias - Register an alias to a list of rules, like RegisterAlias("username", "alpha_dash|min:3|max:32"),
+// so the tags can use "username" instead of the rules. The rules can have parameters, like "$1" and "$2"
+// in RegisterAlias("length_between", "min:$1|max:$2"), informed in the tag as "length_between:3,32".
+// The name of an alias cannot be the name of a rule or a modifier.
+func RegisterAlias(name string, rules string) error {
+	if name == "" || strings.ContainsAny(name, "|:, ") {
+		return fmt.Errorf("Error: The alias %q is not a valid name", name)
+	} else if _, err := ParseTag(rules); err != nil {
+		return err
+	} else if modifiers[name] {
+		return fmt.Errorf("Error: The alias %s is a native modifier of every native type name, you cannot use this name", name)
+	}
+	for validatorKeyType, rulesHandlers := range types {
+		if rulesHandlers[name] != nil {
+			return fmt.Errorf("Error: The alias %s is a rule of %s type name, you cannot use this name", name, validatorKeyType)
+		}
+	}
+	aliases[name] = rules
+	clearCaches()
+	return nil
+}
+
+// DelAlias - Will remove one alias added with RegisterAlias
+func DelAlias(name string) {
+	delete(aliases, name)
+	clearCaches()
+}
+
+// clearCaches - clear the caches of the rules of the tags and of the fields of the structs, so the
+// aliases and the sensitive fields are read again in the next validations
+func clearCaches() {
+	for _, cache := range []*sync.Map{&tagsRules, &structsFields} {
+		cache.Range(func(key, _ interface{}) bool {
+			cache.Delete(key)
+			return true
+		})
+	}
+}
+
+// getTagRules - returns the rules of a tag with the aliases expanded, the rules of each tag are expanded
+// only once. A panic is throwed if the tag is malformed.
+func getTagRules(tags string) []aliasRule {
+	if rules, ok := tagsRules.Load(tags); ok {
+		return rules.([]aliasRule)
+	}
+	rules := expandAliases(mustParseTag(tags), "", make(map[string]bool))
+	tagsRules.Store(tags, rules)
+	return rules
+}
+
+// expandAliases - replace the aliases of the rules by their rules, with the parameters informed in the
+// value of the alias. A panic is throwed if an alias uses itself.
+func expandAliases(rules []TagRule, alias string, expanding map[string]bool) (expandedRules []aliasRule) {
+	for _, rule := range rules {
+		aliasRules, ok := aliases[rule.Name]
+		if !ok {
+			expandedRules = append(expandedRules, aliasRule{rule, alias})
+			continue
+		}
+		if expanding[rule.Name] {
+			panic(fmt.Sprintf("The alias '%s' uses itself", rule.Name))
+		}
+		expanding[rule.Name] = true
+		// the parameters are replaced from the last one, so "$1" does not replace the start of "$10"
+		for i := len(rule.Params); i > 0; i-- {
+			aliasRules = strings.Replace(aliasRules, "$"+strconv.Itoa(i), quoteTagParam(rule.Params[i-1]), -1)
+		}
+		ruleAlias := alias
+		if ruleAlias == "" {
+			ruleAlias = rule.Name
+		}
+		expandedRules = append(expandedRules, expandAliases(mustParseTag(aliasRules), ruleAlias, expanding)...)
+		delete(expanding, rule.Name)
+	}
+	return expandedRules
+}
@@ -0,0 +1,148 @@
+package validator
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	// rulesValuesKinds - relation between the native rules, as "<validator key type>.<rule name>", and the
+	// kind of their values, checked by CheckTag
+	rulesValuesKinds = map[string]string{
+		"numeric.min":                    "number",
+		"numeric.max":                    "number",
+		"string.min":                     "uint",
+		"string.max":                     "uint",
+		"string.length":                  "uint",
+		"string.min_chars":               "uint",
+		"string.max_chars":               "uint",
+		"array.min":                      "uint",
+		"array.max":                      "uint",
+		"string.regex":                   "regex",
+		"timestamp.after":                "timestamp",
+		"timestamp.after_date":           "timestamp",
+		"timestamp.before":               "timestamp",
+		"timestamp.before_date":          "timestamp",
+		"timestamp.equal":                "timestamp",
+		"timestamp.equal_date":           "timestamp",
+		"timestamp.after_or_equal":       "timestamp",
+		"timestamp.before_or_equal":      "timestamp",
+		"timestamp.after_or_equal_date":  "timestamp",
+		"timestamp.before_or_equal_date": "timestamp",
+		"string.required_with":           "fields",
+		"string.required_with_all":       "fields",
+		"string.required_without":        "fields",
+		"string.required_without_all":    "fields",
+		"array.required_with":            "fields",
+		"array.required_with_all":        "fields",
+		"array.required_without":         "fields",
+		"array.required_without_all":     "fields",
+	}
+)
+
+// CheckTag - Check the rules of the tag of a field without validating a value, the mistakes that are
+// panics in the validation are returned as errors. The typeName is the name of the golang type of the
+// field, like "int64", "[]string" or "time.Time", and fieldsNames are the names of the fields of the
+// struct, used by the required_with* rules. The errors are *TagSyntaxError with the position of the rule.
+func CheckTag(typeName string, tag string, fieldsNames []string) (returnedErrors []error) {
+	rules, err := ParseTag(tag)
+	if err != nil {
+		return []error{err}
+	}
+	validatorKeyType := GetValidatorKeyType(typeName)
+	for _, rule := range rules {
+		// the rules of the aliases are reported in the position of the alias
+		for _, expandedRule := range expandAliases([]TagRule{rule}, "", make(map[string]bool)) {
+			if message := checkRule(validatorKeyType, typeName, expandedRule, fieldsNames); message != "" {
+				returnedErrors = append(returnedErrors, &TagSyntaxError{Tag: tag, Pos: rule.Pos, Message: message})
+			}
+		}
+	}
+	return returnedErrors
+}
+
+// CheckMessagesTag - Check the messages tag of a field, like "min=Name too short|email=Invalid e-mail",
+// without validating a value. The rules of the messages have to be in the tags of the field, like the
+// TagName tag and the tags of the groups, and the rules of the aliases are in the tags too. The errors
+// are *TagSyntaxError with the position of the message.
+func CheckMessagesTag(messagesTag string, tags []string) (returnedErrors []error) {
+	rulesNames := []string{}
+	for _, tag := range tags {
+		// the mistakes of the tags are returned by CheckTag
+		rules, _ := ParseTag(tag)
+		for _, rule := range expandAliases(rules, "", make(map[string]bool)) {
+			// the modifiers have no messages
+			if !modifiers[rule.Name] {
+				rulesNames = append(rulesNames, rule.Name)
+			}
+		}
+	}
+	pos := 0
+	for _, ruleMessage := range strings.Split(messagesTag, "|") {
+		ruleName := strings.TrimSpace(strings.SplitN(ruleMessage, "=", 2)[0])
+		// the position of the rule name, after the spaces
+		rulePos := pos + len(ruleMessage) - len(strings.TrimLeft(ruleMessage, " \t"))
+		if !strings.Contains(ruleMessage, "=") && strings.TrimSpace(ruleMessage) != "" {
+			returnedErrors = append(returnedErrors, &TagSyntaxError{Tag: messagesTag, Pos: rulePos, Message: fmt.Sprintf("the message %q has no rule, like rule=message", strings.TrimSpace(ruleMessage))})
+		} else if strings.Contains(ruleMessage, "=") && !containsString(rulesNames, ruleName) {
+			returnedErrors = append(returnedErrors, &TagSyntaxError{Tag: messagesTag, Pos: rulePos, Message: fmt.Sprintf("the message of the rule %s is not used, the field has no rule %s", ruleName, ruleName)})
+		}
+		pos += len(ruleMessage) + 1
+	}
+	return returnedErrors
+}
+
+// checkRule - returns the message of the mistake of the rule, or an empty string if the rule is valid
+func checkRule(validatorKeyType string, typeName string, rule aliasRule, fieldsNames []string) string {
+	if modifiers[rule.Name] {
+		return ""
+	} else if validatorKeyType == "" {
+		return fmt.Sprintf("the type %s has no validator key type, the rule %s is not validated", typeName, rule.Name)
+	} else if types[validatorKeyType][rule.Name] == nil {
+		return fmt.Sprintf("the rule %s does not exist in the %s validator", rule.Name, validatorKeyType)
+	}
+	valueKind, ok := rulesValuesKinds[validatorKeyType+"."+rule.Name]
+	if !ok {
+		return ""
+	} else if rule.Value == "" {
+		return fmt.Sprintf("the rule %s needs a value, like %s:value", rule.Name, rule.Name)
+	}
+	switch valueKind {
+	case "number":
+		if _, err := GetFloatFromString(rule.Value); err != nil {
+			return fmt.Sprintf("the value of the rule %s has to be a number, not %q", rule.Name, rule.Value)
+		}
+	case "uint":
+		if _, err := GetUintFromString(rule.Value); err != nil {
+			return fmt.Sprintf("the value of the rule %s has to be an unsigned integer, not %q", rule.Name, rule.Value)
+		}
+	case "regex":
+		if _, err := regexp.Compile(rule.Value); err != nil {
+			return fmt.Sprintf("the value of the rule %s is not a valid regular expression: %v", rule.Name, err)
+		}
+	case "timestamp":
+		parts := strings.Split(rule.Value, "+")
+		if _, err := strconv.Atoi(strings.Join(parts[1:], "+")); parts[0] != "today" || (len(parts) > 1 && err != nil) || len(parts) > 2 {
+			return fmt.Sprintf("the value of the rule %s has to be today or today+N, not %q", rule.Name, rule.Value)
+		}
+	case "fields":
+		for _, fieldName := range GetFieldsNamesFromRuleString(rule.Value) {
+			if !containsString(fieldsNames, fieldName) {
+				return fmt.Sprintf("the rule %s uses the field %s, that does not exist", rule.Name, fieldName)
+			}
+		}
+	}
+	return ""
+}
+
+// containsString - check if the value is in the list
+func containsString(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
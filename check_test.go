@@ -0,0 +1,65 @@
+package validator
+
+import (
+	"testing"
+)
+
+func TestCheckTag(t *testing.T) {
+	fieldsNames := []string{"Name", "Email", "Phone"}
+	t.Log("\nIt tests the mistakes found in the tags\n")
+	tests := []struct {
+		typeName string
+		tag      string
+		expected string
+	}{
+		{"string", "required|min:3|regex:^[a-z]*$|required_with:Email, Phone", ""},
+		{"time.Time", "after:today+3|before_date:today", ""},
+		{"int64", "email", `Error: Invalid tag "email" at position 0: the rule email does not exist in the numeric validator`},
+		{"string", "required|min", `Error: Invalid tag "required|min" at position 9: the rule min needs a value, like min:value`},
+		{"[]int", "max:ten", `Error: Invalid tag "max:ten" at position 0: the value of the rule max has to be an unsigned integer, not "ten"`},
+		{"float64", "min:1,5", `Error: Invalid tag "min:1,5" at position 0: the value of the rule min has to be a number, not "1,5"`},
+		{"string", "regex:^[a-z$", "Error: Invalid tag \"regex:^[a-z$\" at position 0: the value of the rule regex is not a valid regular expression: error parsing regexp: missing closing ]: `[a-z$`"},
+		{"time.Time", "after:tomorrow", `Error: Invalid tag "after:tomorrow" at position 0: the value of the rule after has to be today or today+N, not "tomorrow"`},
+		{"string", "required_without:Site", `Error: Invalid tag "required_without:Site" at position 0: the rule required_without uses the field Site, that does not exist`},
+		{"bool", "required", `Error: Invalid tag "required" at position 0: the type bool has no validator key type, the rule required is not validated`},
+		{"string", "min:3||max:5", `Error: Invalid tag "min:3||max:5" at position 6: expected a rule name`},
+	}
+	for _, test := range tests {
+		received := ""
+		for _, err := range CheckTag(test.typeName, test.tag, fieldsNames) {
+			received += err.Error()
+		}
+		if received != test.expected {
+			t.Errorf("\nReceived: %v.\nShould be: %v.\n", received, test.expected)
+		}
+	}
+}
+
+func TestCheckMessagesTag(t *testing.T) {
+	if err := RegisterAlias("check_username", "alpha_dash|min:3"); err != nil {
+		t.Fatalf("\nReceived: %v.\nShould be: nil.\n", err)
+	}
+	defer DelAlias("check_username")
+	t.Log("\nIt tests the rules of the messages tags\n")
+	tests := []struct {
+		messagesTag string
+		tags        []string
+		expected    string
+	}{
+		{"required=Required|min=Too short", []string{"required|min:3"}, ""},
+		{"max=Too long", []string{"required", "max:20"}, ""},
+		{"alpha_dash=Only letters", []string{"check_username"}, ""},
+		{"min=Too short| email=Invalid", []string{"min:3"}, `Error: Invalid tag "min=Too short| email=Invalid" at position 15: the message of the rule email is not used, the field has no rule email`},
+		{"omitempty=Empty", []string{"omitempty|min:3"}, `Error: Invalid tag "omitempty=Empty" at position 0: the message of the rule omitempty is not used, the field has no rule omitempty`},
+		{"min=Too short|Invalid", []string{"min:3"}, `Error: Invalid tag "min=Too short|Invalid" at position 14: the message "Invalid" has no rule, like rule=message`},
+	}
+	for _, test := range tests {
+		received := ""
+		for _, err := range CheckMessagesTag(test.messagesTag, test.tags) {
+			received += err.Error()
+		}
+		if received != test.expected {
+			t.Errorf("\nReceived: %v.\nShould be: %v.\n", received, test.expected)
+		}
+	}
+}
@@ -0,0 +1,313 @@
+// The validator-gen command generates the code that lets the validator read the fields of structs without
+// reflection. It is used by go generate, in the file with the structs:
+//
+//	//go:generate validator-gen -type Person,Address
+//
+// For each type, the generated file has the ValidatorValidate method, of the validator.GeneratedValidator
+// interface, that checks the rules of the tags with typed code, like len(st.Name) < 3, and a Validate
+// method that returns the validator.ValidationErrors of the struct. The errors are the same of
+// validator.ValidateDetailed, that uses the generated code when present.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"go/types"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/Wandecilenio01/validator"
+	"golang.org/x/tools/go/packages"
+)
+
+func main() {
+	typesNames := flag.String("type", "", "comma-separated list of the names of the structs")
+	output := flag.String("output", "", "output file, the default is <type>_validator.go")
+	flag.Parse()
+	if *typesNames == "" {
+		fmt.Fprintln(os.Stderr, "Usage: validator-gen -type Person,Address [-output file] [directory]")
+		os.Exit(2)
+	}
+	directory := "."
+	if flag.NArg() > 0 {
+		directory = flag.Arg(0)
+	}
+	source, err := generate(directory, strings.Split(*typesNames, ","))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if *output == "" {
+		*output = filepath.Join(directory, strings.ToLower(strings.Split(*typesNames, ",")[0])+"_validator.go")
+	}
+	if err := os.WriteFile(*output, source, 0644); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// generate - returns the formatted source of the methods of the structs of the package in the directory
+func generate(directory string, typesNames []string) ([]byte, error) {
+	pkgs, err := packages.Load(&packages.Config{Mode: packages.NeedName | packages.NeedTypes, Dir: directory}, ".")
+	if err != nil {
+		return nil, err
+	} else if len(pkgs) != 1 || len(pkgs[0].Errors) > 0 {
+		return nil, fmt.Errorf("Error: The package of %s cannot be loaded: %v", directory, pkgs[0].Errors)
+	}
+	pkg := pkgs[0]
+	source := &bytes.Buffer{}
+	fmt.Fprintf(source, "// Code generated by validator-gen; DO NOT EDIT.\n\npackage %s\n\n", pkg.Name)
+	fmt.Fprintf(source, "import \"github.com/Wandecilenio01/validator\"\n")
+	for _, typeName := range typesNames {
+		object := pkg.Types.Scope().Lookup(strings.TrimSpace(typeName))
+		if object == nil {
+			return nil, fmt.Errorf("Error: The type %s does not exist in the package %s", typeName, pkg.Name)
+		}
+		structType, ok := object.Type().Underlying().(*types.Struct)
+		if !ok {
+			return nil, fmt.Errorf("Error: The type %s is not a struct", typeName)
+		}
+		if err := writeMethods(source, object.Name(), structType); err != nil {
+			return nil, err
+		}
+	}
+	return format.Source(source.Bytes())
+}
+
+// writeMethods - write the ValidatorValidate and Validate methods of a struct, returns an error when a
+// field with rules cannot be validated by the generated code
+func writeMethods(source io.Writer, typeName string, structType *types.Struct) error {
+	fieldsNames := make([]string, structType.NumFields())
+	for i := range fieldsNames {
+		fieldsNames[i] = structType.Field(i).Name()
+	}
+	checks := &bytes.Buffer{}
+	hasTag := false
+	for i := 0; i < structType.NumFields(); i++ {
+		field := structType.Field(i)
+		rulesTag, ok := reflect.StructTag(structType.Tag(i)).Lookup(validator.TagName)
+		hasTag = hasTag || ok
+		if field.Exported() && isSelfValidator(field.Type()) {
+			return fmt.Errorf("Error: The field %s of %s validates itself, it is not supported by validator-gen", field.Name(), typeName)
+		}
+		validatorKeyType := validator.GetValidatorKeyType(getTypeName(field.Type()))
+		if strings.TrimSpace(rulesTag) == "" || validatorKeyType == "" {
+			// the rules are not validated
+			continue
+		} else if !field.Exported() || strings.HasPrefix(getTypeName(field.Type()), "*") || isValuer(field.Type()) {
+			return fmt.Errorf("Error: The field %s of %s is unexported, a pointer or a driver.Valuer, it is not supported by validator-gen", field.Name(), typeName)
+		}
+		rules, err := validator.ParseTag(rulesTag)
+		if err != nil {
+			return fmt.Errorf("Error: The field %s of %s has invalid rules: %v", field.Name(), typeName, err)
+		}
+		fmt.Fprintf(checks, "\t// %s\n", field.Name())
+		if len(validator.CheckTag(getTypeName(field.Type()), rulesTag, fieldsNames)) > 0 {
+			// the aliases and the custom rules are known only at runtime
+			fmt.Fprintf(checks, "\tvalidation.CheckField(%d, %s)\n", i, getFieldValue(field, validatorKeyType))
+			continue
+		}
+		writeFieldChecks(checks, i, field, validatorKeyType, rules)
+	}
+	if !hasTag {
+		return fmt.Errorf("Error: The type %s has no %s tag", typeName, validator.TagName)
+	}
+	fmt.Fprintf(source, "\n// ValidatorValidate - Validates the fields of %s with the rules of the %s tag,\n", typeName, validator.TagName)
+	fmt.Fprintf(source, "// returns false when the validator.TagName is another tag\n")
+	fmt.Fprintf(source, "func (st %s) ValidatorValidate(validation *validator.GeneratedValidation) bool {\n", typeName)
+	fmt.Fprintf(source, "\tif validator.TagName != %q {\n\t\treturn false\n\t}\n%s\treturn true\n}\n", validator.TagName, checks)
+	fmt.Fprintf(source, "\n// Validate - Validates %s with the rules of its tags\n", typeName)
+	fmt.Fprintf(source, "func (st %s) Validate() validator.ValidationErrors {\n", typeName)
+	fmt.Fprintf(source, "\tvalidation := validator.NewGeneratedValidation(st)\n")
+	fmt.Fprintf(source, "\tif !st.ValidatorValidate(validation) {\n\t\treturn validator.ValidateDetailed(st, nil)\n\t}\n")
+	fmt.Fprintf(source, "\treturn validation.Errors()\n}\n")
+	return nil
+}
+
+// writeFieldChecks - write the checks of the rules of a field, the rules with a typed check call
+// validation.Check only when the check fails. With the "bail" modifier the checks are the cases of a
+// switch, so only the first failing rule has an error.
+func writeFieldChecks(checks io.Writer, index int, field *types.Var, validatorKeyType string, rules []validator.TagRule) {
+	value := getFieldValue(field, validatorKeyType)
+	bail, conditions := false, []string{}
+	for _, rule := range rules {
+		switch rule.Name {
+		case "bail":
+			bail = true
+		case "omitempty":
+			conditions = append(conditions, getNotEmptyCondition(field, validatorKeyType))
+		case "nullable":
+			if _, ok := field.Type().Underlying().(*types.Slice); ok {
+				conditions = append(conditions, "st."+field.Name()+" != nil")
+			}
+		}
+	}
+	indent := "\t"
+	if len(conditions) > 0 {
+		fmt.Fprintf(checks, "\tif %s {\n", strings.Join(conditions, " && "))
+		indent = "\t\t"
+	}
+	if bail {
+		fmt.Fprintf(checks, "%sswitch {\n", indent)
+	}
+	for i, rule := range rules {
+		condition, ok := getRuleCondition(field, validatorKeyType, rule)
+		if !ok {
+			continue
+		}
+		check := fmt.Sprintf("validation.Check(%d, %d, %s)", index, i, value)
+		switch {
+		case bail && condition != "":
+			fmt.Fprintf(checks, "%scase %s && %s:\n", indent, condition, check)
+		case bail:
+			fmt.Fprintf(checks, "%scase %s:\n", indent, check)
+		case condition != "":
+			fmt.Fprintf(checks, "%sif %s {\n%s\t%s\n%s}\n", indent, condition, indent, check, indent)
+		default:
+			fmt.Fprintf(checks, "%s%s\n", indent, check)
+		}
+	}
+	if bail {
+		fmt.Fprintf(checks, "%s}\n", indent)
+	}
+	if len(conditions) > 0 {
+		fmt.Fprintf(checks, "\t}\n")
+	}
+}
+
+// getRuleCondition - returns the typed condition of the failure of a rule, like "len(st.Name) < 3", or an
+// empty condition for the rules checked by the validator, like regex. Returns false for the modifiers and
+// for the rules of updates, that pass without the previous version of the struct.
+func getRuleCondition(field *types.Var, validatorKeyType string, rule validator.TagRule) (string, bool) {
+	switch rule.Name {
+	case "sensitive", "redact", "bail", "omitempty", "nullable", "sometimes", "immutable", "increasing", "no_shrink":
+		return "", false
+	}
+	name, operators := "st."+field.Name(), map[string]string{"min": "<", "max": ">", "length": "!="}
+	ruleValue := rule.Value
+	if rule.Name == "required" {
+		operators["required"], ruleValue = "<", "1"
+	}
+	operator, ok := operators[rule.Name]
+	if !ok || (rule.Name == "length" && validatorKeyType != "string") {
+		return "", true
+	}
+	switch validatorKeyType {
+	case "string":
+		if _, err := strconv.ParseUint(ruleValue, 10, 64); err == nil {
+			return fmt.Sprintf("len(%s) %s %s", name, operator, ruleValue), true
+		}
+	case "array":
+		if _, err := strconv.ParseUint(ruleValue, 10, 64); err == nil && isJSONItem(field.Type()) {
+			return fmt.Sprintf("len(%s) %s %s", name, operator, ruleValue), true
+		}
+	case "numeric":
+		if rule.Name == "required" {
+			break
+		} else if isUnsigned(field.Type()) {
+			if _, err := strconv.ParseUint(ruleValue, 10, 64); err == nil {
+				return fmt.Sprintf("uint64(%s) %s %s", name, operator, ruleValue), true
+			}
+		} else if number, err := strconv.ParseFloat(ruleValue, 64); err == nil && !math.IsInf(number, 0) && !math.IsNaN(number) {
+			return fmt.Sprintf("float64(%s) %s %s", name, operator, strconv.FormatFloat(number, 'g', -1, 64)), true
+		}
+	}
+	return "", true
+}
+
+// getNotEmptyCondition - returns the typed condition of a value that is not empty, used by omitempty
+func getNotEmptyCondition(field *types.Var, validatorKeyType string) string {
+	name := "st." + field.Name()
+	switch validatorKeyType {
+	case "string":
+		return name + ` != ""`
+	case "numeric":
+		return name + " != 0"
+	case "timestamp":
+		return "!" + name + ".IsZero()"
+	}
+	return "len(" + name + ") != 0"
+}
+
+// getFieldValue - returns the expression of the value of a field, converted like the validator does
+// with reflection: the integers and the floats as float64 and the unsigned integers as uint64
+func getFieldValue(field *types.Var, validatorKeyType string) string {
+	if validatorKeyType != "numeric" {
+		return "st." + field.Name()
+	} else if isUnsigned(field.Type()) {
+		return "uint64(st." + field.Name() + ")"
+	}
+	return "float64(st." + field.Name() + ")"
+}
+
+// isUnsigned - check if the type is an unsigned integer
+func isUnsigned(fieldType types.Type) bool {
+	basic, ok := fieldType.Underlying().(*types.Basic)
+	return ok && basic.Info()&types.IsUnsigned != 0
+}
+
+// isJSONItem - check if the items of the list are encoded as a JSON array by the validator, like the
+// strings and the numbers, the []byte are encoded as a string
+func isJSONItem(fieldType types.Type) bool {
+	slice, ok := fieldType.Underlying().(*types.Slice)
+	if !ok {
+		return false
+	}
+	basic, ok := slice.Elem().Underlying().(*types.Basic)
+	return ok && basic.Kind() != types.Byte && basic.Info()&(types.IsBoolean|types.IsInteger|types.IsFloat|types.IsString) != 0
+}
+
+// isSelfValidator - check if the type, or the type of the items of a list, has the Validate() error
+// method of validator.SelfValidator
+func isSelfValidator(fieldType types.Type) bool {
+	for {
+		switch listType := fieldType.Underlying().(type) {
+		case *types.Pointer:
+			fieldType = listType.Elem()
+			continue
+		case *types.Slice:
+			return isSelfValidator(listType.Elem())
+		case *types.Array:
+			return isSelfValidator(listType.Elem())
+		}
+		break
+	}
+	results := getMethodResults(fieldType, "Validate")
+	return results != nil && results.Len() == 1 && types.Identical(results.At(0).Type(), types.Universe.Lookup("error").Type())
+}
+
+// isValuer - check if the type has the Value method of driver.Valuer, like sql.NullString
+func isValuer(fieldType types.Type) bool {
+	results := getMethodResults(fieldType, "Value")
+	return results != nil && results.Len() == 2
+}
+
+// getMethodResults - returns the results of the method of the type, or of a pointer to the type, when it
+// has no parameters, and nil if there's no method
+func getMethodResults(fieldType types.Type, name string) *types.Tuple {
+	for pointer, ok := fieldType.(*types.Pointer); ok; pointer, ok = fieldType.(*types.Pointer) {
+		fieldType = pointer.Elem()
+	}
+	if _, ok := fieldType.Underlying().(*types.Interface); !ok {
+		fieldType = types.NewPointer(fieldType)
+	}
+	object, _, _ := types.LookupFieldOrMethod(fieldType, true, nil, name)
+	if method, ok := object.(*types.Func); ok && method.Type().(*types.Signature).Params().Len() == 0 {
+		return method.Type().(*types.Signature).Results()
+	}
+	return nil
+}
+
+// getTypeName - returns the name of the type like the String method of reflect.Type, like "time.Time"
+func getTypeName(fieldType types.Type) string {
+	return types.TypeString(fieldType, func(pkg *types.Package) string {
+		return pkg.Name()
+	})
+}
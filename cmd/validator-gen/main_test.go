@@ -0,0 +1,62 @@
+package main
+
+import (
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Wandecilenio01/validator"
+	"github.com/Wandecilenio01/validator/cmd/validator-gen/testdata/models"
+)
+
+// reflectedPerson - same fields of models.Person, without the generated methods
+type reflectedPerson models.Person
+
+func TestGenerate(t *testing.T) {
+	t.Log("\nIt tests the code generated for the structs of the testdata\n")
+	source, err := generate("testdata/models", []string{"Person"})
+	if err != nil {
+		t.Fatalf("\nReceived: %v.\nShould be: nil.\n", err)
+	}
+	expected, _ := os.ReadFile("testdata/models/person_validator.go")
+	if string(source) != string(expected) {
+		t.Errorf("\nReceived: %s.\nShould be: %s.\n", source, expected)
+	}
+	if _, err := generate("testdata/models", []string{"Age"}); err == nil {
+		t.Log("\nTests a type that is not a struct\n")
+		t.Errorf("\nReceived: nil.\nShould be: an error.\n")
+	}
+}
+
+func TestGeneratedParity(t *testing.T) {
+	t.Log("\nIt tests if the generated code has the same errors of the reflection for every validator key type\n")
+	if err := validator.RegisterAlias("username", "alpha_dash|min:3"); err != nil {
+		t.Fatalf("\nReceived: %v.\nShould be: nil.\n", err)
+	}
+	defer validator.DelAlias("username")
+	now := time.Now()
+	people := []models.Person{
+		{ID: 5, Name: "Ana", Email: "ana@example.com", Password: "12345678", Username: "ana", Level: 1, Score: 9.5, CreateAt: now.AddDate(0, 0, -1)},
+		{ID: 1, Name: "R2-D2", Nickname: "R2", Email: "r2", Password: "123", Username: "r2 d2", Level: 11, Score: 9.75, Tags: []string{}, CreateAt: now.AddDate(0, 0, 2), UpdateAt: now.AddDate(0, 0, -1)},
+		{ID: 21, Nickname: "C-3PO", Username: "c", Tags: []string{"a", "b", "a", "c"}},
+		{ID: 2, Name: "Han", Password: "123456789", Username: "han", Level: 10, Score: 9.51, Tags: []string{"a", "b", "c"}},
+		{ID: 3, Name: "Leia", Nickname: "leia", Password: "12345678", Username: "leia", Level: 10, Tags: []string{"a"}, UpdateAt: now.AddDate(0, 0, 2)},
+	}
+	for _, person := range people {
+		expected := validator.ValidateDetailed(reflectedPerson(person), nil)
+		if errorsReceived := person.Validate(); !reflect.DeepEqual(errorsReceived, expected) {
+			t.Errorf("\nReceived: %v.\nShould be: %v.\n", errorsReceived, expected)
+		}
+		if errorsReceived := validator.ValidateDetailed(&person, nil); !reflect.DeepEqual(errorsReceived, expected) {
+			t.Log("\nTests the generated code used by ValidateDetailed\n")
+			t.Errorf("\nReceived: %v.\nShould be: %v.\n", errorsReceived, expected)
+		}
+		brazilianValidator := &validator.Validator{Locale: "pt-BR", Messages: map[string]map[string]string{"email": {"email": "E-mail inválido"}}}
+		expected = brazilianValidator.ValidateDetailed(reflectedPerson(person))
+		if errorsReceived := brazilianValidator.ValidateDetailed(person); !reflect.DeepEqual(errorsReceived, expected) {
+			t.Log("\nTests the generated code with the configuration of a validator\n")
+			t.Errorf("\nReceived: %v.\nShould be: %v.\n", errorsReceived, expected)
+		}
+	}
+}
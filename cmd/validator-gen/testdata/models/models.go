@@ -0,0 +1,21 @@
+package models
+
+import "time"
+
+type Age uint8
+
+type Person struct {
+	ID       int64     `json:"id" struct-validator:"min:3|max:20"`
+	Name     string    `json:"name" struct-validator:"required|alpha_space"`
+	Nickname string    `json:"nickname" struct-validator:"omitempty|bail|min:3|alpha"`
+	Email    string    `json:"email" struct-validator:"required_with:Nickname|email"`
+	Password string    `json:"password" struct-validator:"length:8|sensitive"`
+	Username string    `json:"username" struct-validator:"username"`
+	Age      Age       `json:"age" struct-validator:"min:18"`
+	Level    uint8     `json:"level" struct-validator:"min:1|max:10"`
+	Score    float32   `json:"score" struct-validator:"max:9.5"`
+	Tags     []string  `json:"tags" struct-validator:"nullable|min:1|max:3|distinct"`
+	CreateAt time.Time `json:"createAt" struct-validator:"before_or_equal:today"`
+	UpdateAt time.Time `json:"updateAt" struct-validator:"omitempty|after:today"`
+	active   bool
+}
@@ -0,0 +1,131 @@
+// The validator command validates data files with the validator rules, and checks the validator tags of
+// Go packages:
+//
+//	validator check --rules rules.yaml data.json [data.yaml...]
+//	validator check --schema schema.json data.json
+//	validator lint ./...
+//
+// The check subcommand writes one line per error and exits with the status 1 when some file has errors.
+// The data files are JSON or YAML objects, or arrays of objects, like exported datasets.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/Wandecilenio01/validator"
+	"github.com/Wandecilenio01/validator/validatorcheck"
+	"golang.org/x/tools/go/analysis/singlechecker"
+	"gopkg.in/yaml.v3"
+)
+
+const usage = `Usage:
+  validator check (--rules FILE | --schema FILE) DATA...
+  validator lint [-rules string.username,...] PACKAGES...
+`
+
+func main() {
+	if len(os.Args) > 1 && os.Args[1] == "lint" {
+		// the analyzer parses the flags and the packages of the arguments
+		os.Args = append(os.Args[:1], os.Args[2:]...)
+		singlechecker.Main(validatorcheck.Analyzer)
+	}
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run - run the subcommand of the arguments and returns the exit status: 0 without errors, 1 when the
+// data has validation errors and 2 when the command cannot run
+func run(args []string, stdout io.Writer, stderr io.Writer) int {
+	if len(args) == 0 || args[0] != "check" {
+		fmt.Fprint(stderr, usage)
+		return 2
+	}
+	flags := flag.NewFlagSet("check", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	rulesPath := flags.String("rules", "", "rules file, JSON or YAML")
+	schemaPath := flags.String("schema", "", "JSON Schema file")
+	if err := flags.Parse(args[1:]); err != nil {
+		return 2
+	} else if (*rulesPath == "") == (*schemaPath == "") || flags.NArg() == 0 {
+		fmt.Fprint(stderr, usage)
+		return 2
+	}
+	var ruleSet *validator.RuleSet
+	var err error
+	if *rulesPath != "" {
+		ruleSet, err = validator.LoadRulesFile(*rulesPath)
+	} else {
+		ruleSet, err = validator.LoadJSONSchemaFile(*schemaPath)
+	}
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 2
+	}
+	status := 0
+	for _, path := range flags.Args() {
+		fileStatus, err := checkFile(ruleSet, path, stdout)
+		if err != nil {
+			fmt.Fprintf(stderr, "%s: %v\n", path, err)
+			fileStatus = 2
+		}
+		if fileStatus > status {
+			status = fileStatus
+		}
+	}
+	return status
+}
+
+// checkFile - validate the objects of a data file, writing one line per error, and returns 1 if the
+// file has errors
+func checkFile(ruleSet *validator.RuleSet, path string, stdout io.Writer) (int, error) {
+	data, err := readDataFile(path)
+	if err != nil {
+		return 0, err
+	}
+	status := 0
+	records, isList := data.([]interface{})
+	if !isList {
+		records = []interface{}{data}
+	}
+	for i, record := range records {
+		values, ok := record.(map[string]interface{})
+		if !ok {
+			return 0, errors.New("Error: The data have to be an object or a list of objects")
+		}
+		location := path
+		if isList {
+			location = fmt.Sprintf("%s[%d]", path, i)
+		}
+		for _, err := range ruleSet.Validate(values, nil) {
+			fmt.Fprintf(stdout, "%s: %v\n", location, err)
+			status = 1
+		}
+	}
+	return status, nil
+}
+
+// readDataFile - read a JSON or YAML data file, the YAML values are converted to the same values of a
+// decoded JSON
+func readDataFile(path string) (data interface{}, err error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	switch filepath.Ext(path) {
+	case ".yaml", ".yml":
+		var yamlData interface{}
+		if err := yaml.Unmarshal(content, &yamlData); err != nil {
+			return nil, err
+		}
+		if content, err = json.Marshal(yamlData); err != nil {
+			return nil, err
+		}
+	}
+	err = json.Unmarshal(content, &data)
+	return data, err
+}
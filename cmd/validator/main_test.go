@@ -0,0 +1,44 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	directory := t.TempDir()
+	files := map[string]string{
+		"rules.yaml":  "name: {type: string, rules: \"min:3\", required: true}\nage: {type: integer, rules: \"min:18\"}\n",
+		"valid.json":  `{"name": "Robert", "age": 18}`,
+		"people.json": `[{"name": "Robert", "age": 18}, {"name": "Jo", "age": 17}]`,
+		"person.yaml": "age: 20\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(directory, name), []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	rules := filepath.Join(directory, "rules.yaml")
+	tests := []struct {
+		args   []string
+		status int
+		output string
+	}{
+		{[]string{"check", "--rules", rules, filepath.Join(directory, "valid.json")}, 0, ""},
+		{[]string{"check", "--rules", rules, filepath.Join(directory, "people.json"), filepath.Join(directory, "person.yaml")}, 1,
+			filepath.Join(directory, "people.json") + "[1]: The age cannot be less than 18, the value informed was 17.\n" +
+				filepath.Join(directory, "people.json") + "[1]: The name cannot have length less than 3, the informed value was \"Jo\".\n" +
+				filepath.Join(directory, "person.yaml") + ": The name is required.\n"},
+		{[]string{"check", filepath.Join(directory, "valid.json")}, 2, ""},
+		{[]string{"check", "--rules", rules, filepath.Join(directory, "missing.json")}, 2, ""},
+	}
+	t.Log("\nIt tests the status and the report of the check subcommand\n")
+	for _, test := range tests {
+		stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+		if status := run(test.args, stdout, stderr); status != test.status || stdout.String() != test.output {
+			t.Errorf("\nReceived: %d, %q, %q.\nShould be: %d, %q.\n", status, stdout.String(), stderr.String(), test.status, test.output)
+		}
+	}
+}
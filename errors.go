@@ -3,6 +3,8 @@ package validator
 import (
 	"bytes"
 	"errors"
+	htmltemplate "html/template"
+	"strings"
 	"text/template"
 )
 
@@ -16,83 +18,250 @@ func init() {
 	nativeMessages = map[string]map[string]string{
 		// int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, uintptr, float32, float64
 		"numeric": map[string]string{
-			"min": "The {{.fieldName}} cannot be less than {{.ruleValue}}, the value informed was {{.value}}.",
-			"max": "The {{.fieldName}} cannot be greater than {{.ruleValue}}, the value informed was {{.value}}.",
+			"min":        "The {{.label}} cannot be less than {{.ruleValue}}, the value informed was {{.value}}.",
+			"max":        "The {{.label}} cannot be greater than {{.ruleValue}}, the value informed was {{.value}}.",
+			"immutable":  "The {{.label}} cannot be changed, the previous value was {{.previousValue}} and the value informed was {{.value}}.",
+			"increasing": "The {{.label}} cannot be less than the previous value {{.previousValue}}, the value informed was {{.value}}.",
 		},
 		// array's in general
 		"array": map[string]string{
-			"min":                  "The {{.fieldName}} cannot have length less than {{.ruleValue}}, the value informed was {{.value}}.",
-			"max":                  "The {{.fieldName}} cannot have length greater than {{.ruleValue}}, the value informed was {{.value}}.",
-			"distinct":             "The {{.fieldName}} cannot have to be {{.ruleName}} and cannot have repeated itens, the value informed was {{.value}}.",
-			"required_with":        "The {{.fieldName}} is not a valid {{.ruleName}}, because if at leat one of that fields: ({{.ruleValue}}) is filled, then {{.fieldName}} needs to be filled too.",
-			"required_with_all":    "The {{.fieldName}} is not a valid {{.ruleName}}, because if all fields: ({{.ruleValue}}) are filled, then {{.fieldName}} needs to be filled too.",
-			"required_without":     "The {{.fieldName}} is not a valid {{.ruleName}}, because if at least one that fields: ({{.ruleValue}}) are not filled, then {{.fieldName}} needs to be filled.",
-			"required_without_all": "The {{.fieldName}} is not a valid {{.ruleName}}, because if all fields: ({{.ruleValue}}) are not filled, then {{.fieldName}} needs to be filled.",
+			"min":                  "The {{.label}} cannot have length less than {{.ruleValue}}, the value informed was {{.value}}.",
+			"max":                  "The {{.label}} cannot have length greater than {{.ruleValue}}, the value informed was {{.value}}.",
+			"distinct":             "The {{.label}} cannot have to be {{.ruleName}} and cannot have repeated itens, the value informed was {{.value}}.",
+			"immutable":            "The {{.label}} cannot be changed, the previous value was {{.previousValue}} and the value informed was {{.value}}.",
+			"no_shrink":            "The {{.label}} cannot have less items than the previous value {{.previousValue}}, the value informed was {{.value}}.",
+			"required_with":        "The {{.label}} is not a valid {{.ruleName}}, because if at leat one of that fields: ({{.ruleValue}}) is filled, then {{.label}} needs to be filled too.",
+			"required_with_all":    "The {{.label}} is not a valid {{.ruleName}}, because if all fields: ({{.ruleValue}}) are filled, then {{.label}} needs to be filled too.",
+			"required_without":     "The {{.label}} is not a valid {{.ruleName}}, because if at least one that fields: ({{.ruleValue}}) are not filled, then {{.label}} needs to be filled.",
+			"required_without_all": "The {{.label}} is not a valid {{.ruleName}}, because if all fields: ({{.ruleValue}}) are not filled, then {{.label}} needs to be filled.",
 		},
 		// string
 		"string": map[string]string{
-			"min":   "The {{.fieldName}} cannot have length less than {{.ruleValue}}, the informed value was \"{{.value}}\".",
-			"max":   "The {{.fieldName}} cannot have length greater than {{.ruleValue}}, the informed value was \"{{.value}}\".",
-			"email": "The {{.fieldName}} is not a valid {{.ruleName}}, the informed value was \"{{.value}}\".",
-			"url":   "The {{.fieldName}} is not a valid {{.ruleName}}, the informed value was \"{{.value}}\".",
-			"ipv4":  "The {{.fieldName}} is not a valid {{.ruleName}}, the informed value was \"{{.value}}\".",
-			// "ipv6":  "The {{.fieldName}} is not a valid {{.ruleValue}}, the informed value was {{.value}}.",
-			"json":                 "The {{.fieldName}} is not a valid {{.ruleName}}, the informed value was \"{{.value}}\".",
-			"alpha":                "The {{.fieldName}} is not a valid {{.ruleName}}, the informed value was \"{{.value}}\".",
-			"alpha_dash":           "The {{.fieldName}} is not a valid {{.ruleName}}, the informed value was \"{{.value}}\".",
-			"alpha_num":            "The {{.fieldName}} is not a valid {{.ruleName}}, the informed value was \"{{.value}}\".",
-			"alpha_space":          "The {{.fieldName}} is not a valid {{.ruleName}}, the informed value was \"{{.value}}\".",
-			"alpha_dash_space":     "The {{.fieldName}} is not a valid {{.ruleName}}, the informed value was \"{{.value}}\".",
-			"alpha_num_space":      "The {{.fieldName}} is not a valid {{.ruleName}}, the informed value was \"{{.value}}\".",
-			"length":               "The {{.fieldName}} cannot have length different than {{.ruleValue}}, the length of informed value was \"{{.value}}\".",
-			"regex":                "The {{.fieldName}} is not a valid {{.ruleName}}:{{.ruleValue}} , the informed value was {{.value}}.",
-			"required_with":        "The {{.fieldName}} is not a valid {{.ruleName}}, because if at leat one of that fields: ({{.ruleValue}}) is filled, then {{.fieldName}} needs to be filled too.",
-			"required_with_all":    "The {{.fieldName}} is not a valid {{.ruleName}}, because if all fields: ({{.ruleValue}}) are filled, then {{.fieldName}} needs to be filled too.",
-			"required_without":     "The {{.fieldName}} is not a valid {{.ruleName}}, because if at least one that fields: ({{.ruleValue}}) are not filled, then {{.fieldName}} needs to be filled.",
-			"required_without_all": "The {{.fieldName}} is not a valid {{.ruleName}}, because if all fields: ({{.ruleValue}}) are not filled, then {{.fieldName}} needs to be filled.",
+			"min":   "The {{.label}} cannot have length less than {{.ruleValue}}, the informed value was \"{{.value}}\".",
+			"max":   "The {{.label}} cannot have length greater than {{.ruleValue}}, the informed value was \"{{.value}}\".",
+			"email": "The {{.label}} is not a valid {{.ruleName}}, the informed value was \"{{.value}}\".",
+			"url":   "The {{.label}} is not a valid {{.ruleName}}, the informed value was \"{{.value}}\".",
+			"ipv4":  "The {{.label}} is not a valid {{.ruleName}}, the informed value was \"{{.value}}\".",
+			// "ipv6":  "The {{.label}} is not a valid {{.ruleValue}}, the informed value was {{.value}}.",
+			"json":                 "The {{.label}} is not a valid {{.ruleName}}, the informed value was \"{{.value}}\".",
+			"alpha":                "The {{.label}} is not a valid {{.ruleName}}, the informed value was \"{{.value}}\".",
+			"alpha_dash":           "The {{.label}} is not a valid {{.ruleName}}, the informed value was \"{{.value}}\".",
+			"alpha_num":            "The {{.label}} is not a valid {{.ruleName}}, the informed value was \"{{.value}}\".",
+			"alpha_space":          "The {{.label}} is not a valid {{.ruleName}}, the informed value was \"{{.value}}\".",
+			"alpha_dash_space":     "The {{.label}} is not a valid {{.ruleName}}, the informed value was \"{{.value}}\".",
+			"alpha_num_space":      "The {{.label}} is not a valid {{.ruleName}}, the informed value was \"{{.value}}\".",
+			"min_chars":            "The {{.label}} cannot have less than {{.ruleValue}} characters, the informed value was \"{{.value}}\".",
+			"max_chars":            "The {{.label}} cannot have more than {{.ruleValue}} characters, the informed value was \"{{.value}}\".",
+			"length":               "The {{.label}} cannot have length different than {{.ruleValue}}, the length of informed value was \"{{.value}}\".",
+			"regex":                "The {{.label}} is not a valid {{.ruleName}}:{{.ruleValue}} , the informed value was {{.value}}.",
+			"immutable":            "The {{.label}} cannot be changed, the previous value was \"{{.previousValue}}\" and the informed value was \"{{.value}}\".",
+			"required_with":        "The {{.label}} is not a valid {{.ruleName}}, because if at leat one of that fields: ({{.ruleValue}}) is filled, then {{.label}} needs to be filled too.",
+			"required_with_all":    "The {{.label}} is not a valid {{.ruleName}}, because if all fields: ({{.ruleValue}}) are filled, then {{.label}} needs to be filled too.",
+			"required_without":     "The {{.label}} is not a valid {{.ruleName}}, because if at least one that fields: ({{.ruleValue}}) are not filled, then {{.label}} needs to be filled.",
+			"required_without_all": "The {{.label}} is not a valid {{.ruleName}}, because if all fields: ({{.ruleValue}}) are not filled, then {{.label}} needs to be filled.",
 		},
 		// timestamp
 		"timestamp": map[string]string{
-			"equal":                "The {{.fieldName}} have to be equals to {{.ruleValue}}, the timestamp informed was {{.value}}.",
-			"after":                "The {{.fieldName}} have to be after {{.ruleValue}}, the timestamp informed was {{.value}}.",
-			"before":               "The {{.fieldName}} have to be before {{.ruleValue}}, the timestamp informed was {{.value}}.",
-			"equal_date":           "The {{.fieldName}} have to be equals to {{.ruleValue}}, the date informed was {{.value}}.",
-			"after_date":           "The {{.fieldName}} have to be after {{.ruleValue}}, the date informed was {{.value}}.",
-			"before_date":          "The {{.fieldName}} have to be before {{.ruleValue}}, the date informed was {{.value}}.",
-			"after_or_equal":       "The {{.fieldName}} have to be after or equals to {{.ruleValue}}, the timestamp informed was {{.value}}.",
-			"before_or_equal":      "The {{.fieldName}} have to be before or equals to {{.ruleValue}}, the timestamp informed was {{.value}}.",
-			"after_or_equal_date":  "The {{.fieldName}} have to be after or equals to {{.ruleValue}}, the date informed was {{.value}}.",
-			"before_or_equal_date": "The {{.fieldName}} have to be before or equals to {{.ruleValue}}, the date informed was {{.value}}.",
+			"equal":                "The {{.label}} have to be equals to {{.ruleValue}}, the timestamp informed was {{.value}}.",
+			"after":                "The {{.label}} have to be after {{.ruleValue}}, the timestamp informed was {{.value}}.",
+			"before":               "The {{.label}} have to be before {{.ruleValue}}, the timestamp informed was {{.value}}.",
+			"equal_date":           "The {{.label}} have to be equals to {{.ruleValue}}, the date informed was {{.value}}.",
+			"after_date":           "The {{.label}} have to be after {{.ruleValue}}, the date informed was {{.value}}.",
+			"before_date":          "The {{.label}} have to be before {{.ruleValue}}, the date informed was {{.value}}.",
+			"after_or_equal":       "The {{.label}} have to be after or equals to {{.ruleValue}}, the timestamp informed was {{.value}}.",
+			"before_or_equal":      "The {{.label}} have to be before or equals to {{.ruleValue}}, the timestamp informed was {{.value}}.",
+			"after_or_equal_date":  "The {{.label}} have to be after or equals to {{.ruleValue}}, the date informed was {{.value}}.",
+			"before_or_equal_date": "The {{.label}} have to be before or equals to {{.ruleValue}}, the date informed was {{.value}}.",
+			"immutable":            "The {{.label}} cannot be changed, the previous timestamp was {{.previousValue}} and the timestamp informed was {{.value}}.",
+			"increasing":           "The {{.label}} cannot be before the previous timestamp {{.previousValue}}, the timestamp informed was {{.value}}.",
+		},
+		// JSON Schema rule sets
+		"schema": map[string]string{
+			"required": "The {{.label}} is required.",
+			"type":     "The {{.label}} have to be of type {{.ruleValue}}, the value informed was {{.value}}.",
+		},
+		// forms and query strings
+		"form": map[string]string{
+			"type": "The {{.label}} is not a valid {{typeName .ruleValue}}, the informed value was \"{{.value}}\".",
 		},
 	}
 }
 
-// GenerateErrorMessage - Generate an error using the messageInput.CustomMessages or the nativeMessages
+// GenerateErrorMessage - Generate an error using the first message found in this order: the
+// messageInput.CustomMessages by "*", the message of the rule in the messages tag of the field, the
+// messageInput.CustomMessages by field name, by path and by 'validator key type', and the messages of the
+// messageInput.Locale
 func GenerateErrorMessage(messageInput MessageInput) error {
-	if messageInput.CustomMessages["*"] != nil && messageInput.CustomMessages["*"][messageInput.RuleName] != "" {
-		//there's some custom message for every field and that especific rule
-		messageInput.ValidatorKeyType = "*"
-		return TemplateErrorMessage(messageInput)
-	} else if messageInput.CustomMessages[messageInput.FieldName] != nil && messageInput.CustomMessages[messageInput.FieldName][messageInput.RuleName] != "" {
-		//there's some custom message for that especific field and rule
-		messageInput.ValidatorKeyType = messageInput.FieldName
-		return TemplateErrorMessage(messageInput)
-	}
-	//there's no custom message for that field and rule
-	messageInput.CustomMessages = nativeMessages
+	if message, ok := getCustomMessage(messageInput); ok {
+		return templateMessage(message, messageInput)
+	}
+	//there's no custom message for that field and rule, use the messages of the locale
+	messageInput.CustomMessages = getLocaleMessages(messageInput.Locale, messageInput.ValidatorKeyType, messageInput.RuleName)
 	return TemplateErrorMessage(messageInput)
 }
 
+// getCustomMessage - returns the message of the rule in the messages tag of the field or in the
+// messageInput.CustomMessages, and false if there's no custom message for the field and rule
+func getCustomMessage(messageInput MessageInput) (string, bool) {
+	//the messages of "*" are used by every field
+	if message := messageInput.CustomMessages["*"][messageInput.RuleName]; message != "" {
+		return message, true
+	} else if message := messageInput.TagMessages[messageInput.RuleName]; message != "" {
+		return message, true
+	}
+	for _, key := range []string{messageInput.FieldName, messageInput.Path, messageInput.ValidatorKeyType} {
+		if message := messageInput.CustomMessages[key][messageInput.RuleName]; key != "" && message != "" {
+			return message, true
+		}
+	}
+	return "", false
+}
+
 // TemplateErrorMessage - Returns an error with a templated string using attributes of messageInput parameter
 func TemplateErrorMessage(messageInput MessageInput) error {
-	var errorMessage bytes.Buffer
-	if err := template.Must(template.New("ErrorMessageTemplate").Parse(messageInput.CustomMessages[messageInput.ValidatorKeyType][messageInput.RuleName])).Execute(&errorMessage, map[string]interface{}{"fieldName": messageInput.FieldName, "value": messageInput.FieldValue, "ruleValue": messageInput.RuleValue, "ruleName": messageInput.RuleName}); err != nil {
+	return templateMessage(messageInput.CustomMessages[messageInput.ValidatorKeyType][messageInput.RuleName], messageInput)
+}
+
+// templateMessage - Returns an error with the message templated with the attributes of messageInput,
+// using the messageInput.Renderer or the TextRenderer. The value of sensitive fields is masked.
+func templateMessage(message string, messageInput MessageInput) error {
+	label := messageInput.Label
+	if label == "" {
+		label = messageInput.FieldName
+	}
+	value, previousValue := messageInput.FieldValue, messageInput.PreviousValue
+	if messageInput.Sensitive {
+		value, previousValue = redactedValue(RedactValue(value)), redactedValue(RedactValue(previousValue))
+	}
+	renderer := messageInput.Renderer
+	if renderer == nil {
+		renderer = TextRenderer{}
+	}
+	errorMessage, err := renderer.RenderMessage(message, getTemplateFuncs(messageInput.Locale), map[string]interface{}{"fieldName": messageInput.FieldName, "label": label, "value": value, "previousValue": previousValue, "ruleValue": messageInput.RuleValue, "ruleName": messageInput.RuleName, "locale": messageInput.Locale})
+	if err != nil {
 		panic(err)
 	}
-	return errors.New(errorMessage.String())
+	return errors.New(errorMessage)
+}
+
+// MessageRenderer - Renderer of the templates of the messages
+type MessageRenderer interface {
+	RenderMessage(message string, funcs template.FuncMap, data map[string]interface{}) (string, error)
+}
+
+// TextRenderer - Renderer of the messages as plain text, using text/template, the values are written
+// without escaping
+type TextRenderer struct{}
+
+// HTMLRenderer - Renderer of the messages as HTML, using html/template, the values are escaped, so the
+// messages can be written in HTML pages
+type HTMLRenderer struct{}
+
+// RenderMessage - Returns the message templated with the data
+func (TextRenderer) RenderMessage(message string, funcs template.FuncMap, data map[string]interface{}) (string, error) {
+	var errorMessage bytes.Buffer
+	messageTemplate, err := template.New("ErrorMessageTemplate").Funcs(funcs).Parse(message)
+	if err == nil {
+		err = messageTemplate.Execute(&errorMessage, data)
+	}
+	return errorMessage.String(), err
+}
+
+// RenderMessage - Returns the message templated with the data, with the values escaped
+func (HTMLRenderer) RenderMessage(message string, funcs template.FuncMap, data map[string]interface{}) (string, error) {
+	var errorMessage bytes.Buffer
+	messageTemplate, err := htmltemplate.New("ErrorMessageTemplate").Funcs(htmltemplate.FuncMap(funcs)).Parse(message)
+	if err == nil {
+		err = messageTemplate.Execute(&errorMessage, data)
+	}
+	return errorMessage.String(), err
+}
+
+// parseTagMessages - get the messages of the messages tag of a field, like "min=Name too short|email=Invalid
+// e-mail"
+func parseTagMessages(tag string) map[string]string {
+	if tag == "" {
+		return nil
+	}
+	messages := make(map[string]string)
+	for _, ruleMessage := range strings.Split(tag, "|") {
+		if parts := strings.SplitN(ruleMessage, "=", 2); len(parts) == 2 {
+			messages[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+		}
+	}
+	return messages
 }
 
 // SetNativeMessages - Sets a custom native message
 func SetNativeMessages(NewNativeMessages map[string]map[string]string) {
 	nativeMessages = NewNativeMessages
 }
+
+// FieldError - Error returned by the rule of a field, with the data used to generate it. Errors that
+// are not related to a field, like a nil interface, have only the Err attribute.
+type FieldError struct {
+	FieldName        string
+	Path             string
+	ValidatorKeyType string
+	RuleName         string
+	RuleValue        string
+	Alias            string
+	Code             string
+	Err              error
+}
+
+// ValidationErrors - List of errors returned by the validation
+type ValidationErrors []FieldError
+
+// newFieldError - Returns a FieldError with the field and rule of messageInput
+func newFieldError(messageInput MessageInput, err error) FieldError {
+	return FieldError{
+		FieldName:        messageInput.FieldName,
+		Path:             messageInput.Path,
+		ValidatorKeyType: messageInput.ValidatorKeyType,
+		RuleName:         messageInput.RuleName,
+		RuleValue:        messageInput.RuleValue,
+		Alias:            messageInput.Alias,
+		Code:             GetErrorCode(messageInput.ValidatorKeyType, messageInput.RuleName),
+		Err:              err,
+	}
+}
+
+// GetErrorCode - Returns the code of the errors of a rule, the code declared with
+// AddCustomValidatorWithCode or "<validator key type>.<rule name>", like "string.email"
+func GetErrorCode(validatorKeyType string, ruleName string) string {
+	if code, ok := errorCodes[validatorKeyType+"."+ruleName]; ok {
+		return code
+	} else if validatorKeyType == "" || ruleName == "" {
+		return ""
+	}
+	return validatorKeyType + "." + ruleName
+}
+
+// Error - Returns the message of the error
+func (fieldError FieldError) Error() string {
+	return fieldError.Err.Error()
+}
+
+// Unwrap - Returns the error returned by the rule
+func (fieldError FieldError) Unwrap() error {
+	return fieldError.Err
+}
+
+// Error - Returns the messages of all errors, one per line
+func (validationErrors ValidationErrors) Error() string {
+	messages := make([]string, len(validationErrors))
+	for i, fieldError := range validationErrors {
+		messages[i] = fieldError.Error()
+	}
+	return strings.Join(messages, "\n")
+}
+
+// Errors - Returns the errors returned by the rules, like the Validate function, or nil if the list is
+// empty
+func (validationErrors ValidationErrors) Errors() (returnedErrors []error) {
+	for _, fieldError := range validationErrors {
+		returnedErrors = append(returnedErrors, fieldError.Err)
+	}
+	return returnedErrors
+}
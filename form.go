@@ -0,0 +1,232 @@
+package validator
+
+import (
+	"errors"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// FormTimeFormats - Formats used to parse time.Time fields of forms without the "time_format" tag
+	FormTimeFormats = []string{time.RFC3339, "2006-01-02T15:04", "2006-01-02"}
+)
+
+// ValidateForm - Bind the values of a query string or a HTML form into the struct pointed by st and
+// validate it. The parameters are matched by the "form" tag, the "json" tag or the field name, repeated
+// parameters are bound into slices and the errors have the parameter name as Path.
+func ValidateForm(values url.Values, st interface{}, messages map[string]map[string]string) ValidationErrors {
+	return validateForm(values, st, options{messages: messages})
+}
+
+// ValidateForm - same as the ValidateForm function, using the configuration of the validator
+func (validator *Validator) ValidateForm(values url.Values, st interface{}) ValidationErrors {
+	return validateForm(values, st, validator.options())
+}
+
+// validateForm - bind the values into the struct pointed by st and validate it with the options
+func validateForm(values url.Values, st interface{}, opts options) (validationErrors ValidationErrors) {
+	stValue := reflect.ValueOf(st)
+	if st == nil || stValue.Kind() != reflect.Ptr || stValue.IsNil() || stValue.Elem().Kind() != reflect.Struct {
+		return append(validationErrors, FieldError{Err: errors.New("Error: The interface passed have to be a pointer to a struct")})
+	}
+	stValue = stValue.Elem()
+	// relation between the paths of the fields and the parameter names
+	parametersNames := make(map[string]string)
+	// the fields with parameters are present for the "sometimes" modifier
+	opts.present = make(map[string]bool)
+	for i := 0; i < stValue.NumField(); i++ {
+		structField := stValue.Type().Field(i)
+		parameterName := getFormName(structField)
+		if structField.PkgPath != "" || parameterName == "-" {
+			continue
+		}
+		parametersNames[getJSONName(structField)] = parameterName
+		parameterValues, ok := values[parameterName]
+		if !ok || !isFormType(structField.Type) {
+			continue
+		}
+		if !isEmptyFormValues(structField.Type, parameterValues) {
+			// empty values of numbers, booleans and times are absent for the "sometimes" modifier
+			opts.present[strings.ToLower(getJSONName(structField))] = true
+		}
+		if err := bindFormValues(stValue.Field(i), parameterValues, structField.Tag.Get("time_format")); err != nil {
+			messageInput := MessageInput{
+				FieldName:        structField.Name,
+				Label:            getFieldLabel(stValue.Type(), structField, opts.locale),
+				Path:             parameterName,
+				FieldType:        structField.Type,
+				FieldValue:       strings.Join(parameterValues, ","),
+				ValidatorKeyType: "form",
+				RuleName:         "type",
+				RuleValue:        err.Error(),
+				CustomMessages:   opts.messages,
+				Sensitive:        isSensitiveField(structField),
+				Locale:           opts.locale,
+				Renderer:         opts.renderer,
+			}
+			validationErrors = append(validationErrors, newFieldError(messageInput, GenerateErrorMessage(messageInput)))
+			if limitedErrors, stop := opts.limitErrors(validationErrors); stop {
+				return limitedErrors
+			}
+		}
+	}
+	for _, fieldError := range validate(st, opts) {
+		if parameterName, ok := parametersNames[fieldError.Path]; ok {
+			if hasFieldError(validationErrors, parameterName) {
+				// the field was not bound
+				continue
+			}
+			fieldError.Path = parameterName
+		}
+		validationErrors = append(validationErrors, fieldError)
+		if limitedErrors, stop := opts.limitErrors(validationErrors); stop {
+			return limitedErrors
+		}
+	}
+	return validationErrors
+}
+
+// getFormName - returns the name of the field in the "form" tag, the "json" tag, or the field name
+func getFormName(structField reflect.StructField) string {
+	if name := strings.Split(structField.Tag.Get("form"), ",")[0]; name != "" {
+		return name
+	}
+	return getJSONName(structField)
+}
+
+// hasFieldError - check if there's an error with the path in validationErrors
+func hasFieldError(validationErrors ValidationErrors, path string) bool {
+	for _, fieldError := range validationErrors {
+		if fieldError.Path == path {
+			return true
+		}
+	}
+	return false
+}
+
+// isFormType - check if values of the type can be bound from a form
+func isFormType(fieldType reflect.Type) bool {
+	if fieldType.Kind() == reflect.Ptr || (fieldType.Kind() == reflect.Slice && fieldType.Elem().Kind() != reflect.Uint8) {
+		fieldType = fieldType.Elem()
+	}
+	switch fieldType.Kind() {
+	case reflect.String, reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr, reflect.Float32, reflect.Float64:
+		return true
+	case reflect.Slice:
+		// []byte
+		return true
+	}
+	return fieldType == reflect.TypeOf(time.Time{})
+}
+
+// isEmptyFormValue - check if the value is empty and the type isn't a string or []byte, like the
+// parameter "page=" of an int field
+func isEmptyFormValue(fieldType reflect.Type, value string) bool {
+	if fieldType.Kind() == reflect.Ptr {
+		fieldType = fieldType.Elem()
+	}
+	return value == "" && fieldType.Kind() != reflect.String && fieldType != reflect.TypeOf([]byte{})
+}
+
+// isEmptyFormValues - check if all the values bound into a field of the type are empty
+func isEmptyFormValues(fieldType reflect.Type, values []string) bool {
+	if fieldType.Kind() == reflect.Slice && fieldType.Elem().Kind() != reflect.Uint8 {
+		fieldType = fieldType.Elem()
+	}
+	for _, value := range values {
+		if !isEmptyFormValue(fieldType, value) {
+			return false
+		}
+	}
+	return true
+}
+
+// bindFormValues - set the field with the values of the parameter, slices receive all values and
+// the other types only the first one. The empty values of numbers, booleans and times are skipped in
+// slices. The error returned has the type code of the expected type: int, uint, float, bool or timestamp.
+func bindFormValues(field reflect.Value, values []string, timeFormat string) error {
+	if field.Kind() == reflect.Slice && field.Type().Elem().Kind() != reflect.Uint8 {
+		slice := reflect.MakeSlice(field.Type(), 0, len(values))
+		for _, value := range values {
+			if isEmptyFormValue(field.Type().Elem(), value) {
+				continue
+			}
+			item := reflect.New(field.Type().Elem()).Elem()
+			if err := bindFormValue(item, value, timeFormat); err != nil {
+				return err
+			}
+			slice = reflect.Append(slice, item)
+		}
+		field.Set(slice)
+		return nil
+	}
+	return bindFormValue(field, values[0], timeFormat)
+}
+
+// bindFormValue - set the field with the value converted to the field type, the empty values of
+// numbers, booleans and times set the zero value, or nil in pointers
+func bindFormValue(field reflect.Value, value string, timeFormat string) error {
+	if isEmptyFormValue(field.Type(), value) {
+		field.Set(reflect.Zero(field.Type()))
+		return nil
+	}
+	if field.Kind() == reflect.Ptr {
+		pointer := reflect.New(field.Type().Elem())
+		if err := bindFormValue(pointer.Elem(), value, timeFormat); err != nil {
+			return err
+		}
+		field.Set(pointer)
+		return nil
+	}
+	if field.Type() == reflect.TypeOf(time.Time{}) {
+		timeFormats := FormTimeFormats
+		if timeFormat != "" {
+			timeFormats = []string{timeFormat}
+		}
+		for _, format := range timeFormats {
+			if timeValue, err := time.Parse(format, value); err == nil {
+				field.Set(reflect.ValueOf(timeValue))
+				return nil
+			}
+		}
+		return errors.New("timestamp")
+	}
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(value)
+	case reflect.Slice:
+		field.SetBytes([]byte(value))
+	case reflect.Bool:
+		boolValue, err := strconv.ParseBool(value)
+		if value == "on" {
+			// checkboxes without value attribute
+			boolValue, err = true, nil
+		}
+		if err != nil {
+			return errors.New("bool")
+		}
+		field.SetBool(boolValue)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		intValue, err := strconv.ParseInt(value, 10, field.Type().Bits())
+		if err != nil {
+			return errors.New("int")
+		}
+		field.SetInt(intValue)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		uintValue, err := strconv.ParseUint(value, 10, field.Type().Bits())
+		if err != nil {
+			return errors.New("uint")
+		}
+		field.SetUint(uintValue)
+	case reflect.Float32, reflect.Float64:
+		floatValue, err := strconv.ParseFloat(value, field.Type().Bits())
+		if err != nil {
+			return errors.New("float")
+		}
+		field.SetFloat(floatValue)
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package validator
+
+import (
+	"errors"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestValidateForm(t *testing.T) {
+	type SearchForm struct {
+		Query string    `form:"q" struct-validator:"required"`
+		Page  int       `json:"page" struct-validator:"min:1"`
+		Tags  []string  `form:"tag" struct-validator:"max:2"`
+		Since time.Time `form:"since"`
+		Limit uint8     `form:"limit"`
+	}
+	t.Log("\nIt tests the binding and the validation of form values\n")
+	form := SearchForm{}
+	values := url.Values{"q": {"golang"}, "page": {"2"}, "tag": {"go", "validator"}, "since": {"2018-08-07"}}
+	if validationErrors := ValidateForm(values, &form, nil); validationErrors != nil {
+		t.Errorf("\nReceived: %v.\nShould be: nil.\n", validationErrors)
+	}
+	expected := SearchForm{"golang", 2, []string{"go", "validator"}, time.Date(2018, 8, 7, 0, 0, 0, 0, time.UTC), 0}
+	if !reflect.DeepEqual(form, expected) {
+		t.Log("\nTests the bound values\n")
+		t.Errorf("\nReceived: %+v.\nShould be: %+v.\n", form, expected)
+	}
+	form = SearchForm{}
+	values = url.Values{"page": {"0"}, "tag": {"a", "b", "c"}, "limit": {"300"}}
+	expectedErrors := ValidationErrors{
+		{FieldName: "Limit", Path: "limit", ValidatorKeyType: "form", RuleName: "type", RuleValue: "uint", Code: "form.type", Err: errors.New(`The Limit is not a valid unsigned integer, the informed value was "300".`)},
+		{FieldName: "Query", Path: "q", ValidatorKeyType: "string", RuleName: "required", Code: "string.required", Err: errors.New(`The Query cannot have length less than 1, the informed value was "".`)},
+		{FieldName: "Page", Path: "page", ValidatorKeyType: "numeric", RuleName: "min", RuleValue: "1", Code: "numeric.min", Err: errors.New("The page cannot be less than 1, the value informed was 0.")},
+		{FieldName: "Tags", Path: "tag", ValidatorKeyType: "array", RuleName: "max", RuleValue: "2", Code: "array.max", Err: errors.New("The Tags cannot have length greater than 2, the value informed was [a b c].")},
+	}
+	if validationErrors := ValidateForm(values, &form, nil); !reflect.DeepEqual(validationErrors, expectedErrors) {
+		t.Log("\nTests the errors keyed by parameter name\n")
+		t.Errorf("\nReceived: %#v.\nShould be: %#v.\n", validationErrors, expectedErrors)
+	}
+}
+
+func TestValidateFormEmptyValues(t *testing.T) {
+	type ListForm struct {
+		Page   int       `form:"page" struct-validator:"min:1"`
+		Size   *int      `form:"size" struct-validator:"sometimes|min:1"`
+		Active bool      `form:"active"`
+		Since  time.Time `form:"since"`
+		IDs    []uint    `form:"id"`
+		Name   string    `form:"name"`
+	}
+	t.Log("\nIt tests the empty values of numbers, booleans and times as absent values\n")
+	form := ListForm{Page: 3}
+	values := url.Values{"page": {""}, "size": {""}, "active": {""}, "since": {""}, "id": {"1", "", "2"}, "name": {""}}
+	expectedErrors := ValidationErrors{
+		{FieldName: "Page", Path: "page", ValidatorKeyType: "numeric", RuleName: "min", RuleValue: "1", Code: "numeric.min", Err: errors.New("The Page cannot be less than 1, the value informed was 0.")},
+	}
+	if validationErrors := ValidateForm(values, &form, nil); !reflect.DeepEqual(validationErrors, expectedErrors) {
+		t.Errorf("\nReceived: %#v.\nShould be: %#v.\n", validationErrors, expectedErrors)
+	}
+	expected := ListForm{IDs: []uint{1, 2}}
+	if !reflect.DeepEqual(form, expected) {
+		t.Log("\nTests the bound values\n")
+		t.Errorf("\nReceived: %+v.\nShould be: %+v.\n", form, expected)
+	}
+}
+
+func TestValidateFormTypeLocale(t *testing.T) {
+	type SearchForm struct {
+		Page  int     `form:"page" struct-validator:"min:1"`
+		Price float64 `form:"price" label:"Preço"`
+	}
+	t.Log("\nIt tests the names of the types in the messages of the locale\n")
+	validator := Validator{Locale: "pt-BR"}
+	values := url.Values{"page": {"a"}, "price": {"b"}}
+	expected := []string{
+		`O campo Page possui um valor inválido para o tipo inteiro, o valor informado foi "a".`,
+		`O campo Preço possui um valor inválido para o tipo número, o valor informado foi "b".`,
+	}
+	validationErrors := validator.ValidateForm(values, &SearchForm{})
+	received := make([]string, len(validationErrors))
+	for i, fieldError := range validationErrors {
+		received[i] = fieldError.Error()
+	}
+	if !reflect.DeepEqual(received, expected) || validationErrors[0].RuleValue != "int" {
+		t.Errorf("\nReceived: %v.\nShould be: %v.\n", received, expected)
+	}
+}
@@ -0,0 +1,215 @@
+package validator
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+)
+
+var (
+	// templateFuncs - functions added with AddTemplateFunc
+	templateFuncs = make(template.FuncMap)
+	// pluralRules - relation between locales and their CLDR plural rule, that returns "one" or "other"
+	pluralRules = map[string]func(number float64) string{
+		"en": pluralOneIfOne,
+		"es": pluralOneIfOne,
+		// CLDR pt: i = 0..1
+		"pt": func(number float64) string {
+			if number >= 0 && number < 2 {
+				return "one"
+			}
+			return "other"
+		},
+		// CLDR pt-PT: i = 1 and v = 0
+		"pt-PT": pluralOneIfOne,
+	}
+	// numberSeparators - relation between locales and their decimal and thousands separators
+	numberSeparators = map[string][2]string{
+		"en": {".", ","},
+		"es": {",", "."},
+		"pt": {",", "."},
+	}
+	// dateFormats - relation between locales and the format of the dates
+	dateFormats = map[string]string{
+		"en":    "2006-01-02",
+		"en-US": "01/02/2006",
+		"es":    "02/01/2006",
+		"pt":    "02/01/2006",
+	}
+	// listConjunctions - relation between locales and the conjunction of the last item of a list
+	listConjunctions = map[string]string{
+		"en": "and",
+		"es": "y",
+		"pt": "e",
+	}
+	// typeNames - relation between locales and the names of the type codes of the form errors
+	typeNames = map[string]map[string]string{
+		"en": {"int": "integer", "uint": "unsigned integer", "float": "number", "bool": "boolean", "timestamp": "timestamp"},
+		"es": {"int": "entero", "uint": "entero sin signo", "float": "número", "bool": "booleano", "timestamp": "fecha"},
+		"pt": {"int": "inteiro", "uint": "inteiro sem sinal", "float": "número", "bool": "booleano", "timestamp": "data"},
+	}
+)
+
+// pluralOneIfOne - CLDR plural rule of en and es: n = 1
+func pluralOneIfOne(number float64) string {
+	if number == 1 {
+		return "one"
+	}
+	return "other"
+}
+
+// AddTemplateFunc - Add a function to the templates of the messages, like the functions of a
+// text/template.FuncMap. The native functions plural, number, date, list, join, truncate and typeName
+// cannot be replaced.
+func AddTemplateFunc(name string, function interface{}) error {
+	if _, ok := getTemplateFuncs("")[name]; ok && templateFuncs[name] == nil {
+		return fmt.Errorf("Error: The function %s is a native function of the templates, you cannot change this function", name)
+	}
+	templateFuncs[name] = function
+	return nil
+}
+
+// AddPluralRule - Add the CLDR plural rule of a locale, the rule returns the plural category of the
+// number, like "one" or "other"
+func AddPluralRule(locale string, rule func(number float64) string) {
+	pluralRules[normalizeLocale(locale)] = rule
+}
+
+// getTemplateFuncs - returns the functions of the templates, using the formats of the locale
+func getTemplateFuncs(locale string) template.FuncMap {
+	chain := GetLocaleChain(locale)
+	funcs := template.FuncMap{
+		// plural - returns one if the count is in the "one" category of the locale, and other if not, the
+		// count can be a number, a numeric string or a list
+		"plural": func(count interface{}, one string, other string) string {
+			if pluralRules[getChainKey(chain, pluralRules)](getTemplateNumber(count)) == "one" {
+				return one
+			}
+			return other
+		},
+		// number - returns the number with the separators of the locale, like 1,234.5 or 1.234,5
+		"number": func(value interface{}) string {
+			if redacted, ok := value.(redactedValue); ok {
+				return string(redacted)
+			}
+			return formatNumber(getTemplateNumber(value), numberSeparators[getChainKey(chain, numberSeparators)])
+		},
+		// date - returns the time.Time with the date format of the locale, or with the layout when informed
+		"date": func(value interface{}, layout ...string) string {
+			timeValue, ok := value.(time.Time)
+			if !ok {
+				return fmt.Sprint(value)
+			} else if len(layout) > 0 {
+				return timeValue.Format(layout[0])
+			}
+			return timeValue.Format(dateFormats[getChainKey(chain, dateFormats)])
+		},
+		// list - returns the items of a list separated by commas and the conjunction of the locale, like
+		// "a, b and c"
+		"list": func(value interface{}) string {
+			if redacted, ok := value.(redactedValue); ok {
+				return string(redacted)
+			}
+			items := getTemplateStrings(value)
+			if len(items) < 2 {
+				return strings.Join(items, "")
+			}
+			return strings.Join(items[:len(items)-1], ", ") + " " + listConjunctions[getChainKey(chain, listConjunctions)] + " " + items[len(items)-1]
+		},
+		// join - returns the items of a list separated by the separator
+		"join": func(value interface{}, separator string) string {
+			if redacted, ok := value.(redactedValue); ok {
+				return string(redacted)
+			}
+			return strings.Join(getTemplateStrings(value), separator)
+		},
+		// truncate - returns the value with at most length characters, with "…" at the end when truncated
+		"truncate": func(value interface{}, length int) string {
+			if redacted, ok := value.(redactedValue); ok {
+				return string(redacted)
+			}
+			runes := []rune(fmt.Sprint(value))
+			if len(runes) <= length {
+				return string(runes)
+			}
+			return string(runes[:length]) + "…"
+		},
+		// typeName - returns the name of a type code in the locale, like "integer" or "inteiro" for "int",
+		// or the code when it has no name
+		"typeName": func(code interface{}) string {
+			if name, ok := typeNames[getChainKey(chain, typeNames)][fmt.Sprint(code)]; ok {
+				return name
+			}
+			return fmt.Sprint(code)
+		},
+	}
+	for name, function := range templateFuncs {
+		if _, ok := funcs[name]; !ok {
+			funcs[name] = function
+		}
+	}
+	return funcs
+}
+
+// getChainKey - returns the first locale of the chain that is a key of the map, or "en"
+func getChainKey(chain []string, localesMap interface{}) string {
+	mapValue := reflect.ValueOf(localesMap)
+	for _, locale := range chain {
+		if mapValue.MapIndex(reflect.ValueOf(locale)).IsValid() {
+			return locale
+		}
+	}
+	return "en"
+}
+
+// getTemplateNumber - returns the float64 of a number, a numeric string or the length of a list
+func getTemplateNumber(value interface{}) float64 {
+	reflectValue := reflect.ValueOf(value)
+	switch reflectValue.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(reflectValue.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(reflectValue.Uint())
+	case reflect.Float32, reflect.Float64:
+		return reflectValue.Float()
+	case reflect.String:
+		number, _ := strconv.ParseFloat(reflectValue.String(), 64)
+		return number
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return float64(reflectValue.Len())
+	}
+	return 0
+}
+
+// getTemplateStrings - returns the items of a list as strings, or the value as the only item
+func getTemplateStrings(value interface{}) []string {
+	reflectValue := reflect.ValueOf(value)
+	if reflectValue.Kind() != reflect.Slice && reflectValue.Kind() != reflect.Array {
+		return []string{fmt.Sprint(value)}
+	}
+	items := make([]string, reflectValue.Len())
+	for i := range items {
+		items[i] = fmt.Sprint(reflectValue.Index(i).Interface())
+	}
+	return items
+}
+
+// formatNumber - returns the number with the decimal and thousands separators
+func formatNumber(number float64, separators [2]string) string {
+	parts := strings.SplitN(strconv.FormatFloat(math.Abs(number), 'f', -1, 64), ".", 2)
+	integer := parts[0]
+	for i := len(integer) - 3; i > 0; i -= 3 {
+		integer = integer[:i] + separators[1] + integer[i:]
+	}
+	if len(parts) == 2 {
+		integer += separators[0] + parts[1]
+	}
+	if number < 0 {
+		return "-" + integer
+	}
+	return integer
+}
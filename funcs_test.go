@@ -0,0 +1,51 @@
+package validator
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTemplateFuncs(t *testing.T) {
+	t.Log("\nIt tests the functions of the templates with the formats of the locales\n")
+	tests := []struct {
+		locale   string
+		message  string
+		value    interface{}
+		expected string
+	}{
+		{"pt-BR", `{{.value}} {{plural .value "caractere" "caracteres"}}`, 0.0, "0 caractere"},
+		{"pt-BR", `{{.value}} {{plural .value "caractere" "caracteres"}}`, 2.0, "2 caracteres"},
+		{"en", `{{.value}} {{plural .value "character" "characters"}}`, 0.0, "0 characters"},
+		{"es", `{{plural .value "elemento" "elementos"}}`, []int{7}, "elemento"},
+		{"pt-BR", `{{number .value}}`, 1234567.5, "1.234.567,5"},
+		{"en", `{{number .value}}`, -1234.0, "-1,234"},
+		{"pt-BR", `{{date .value}}`, time.Date(2018, 8, 7, 0, 0, 0, 0, time.UTC), "07/08/2018"},
+		{"es", `{{list .value}}`, []int{1, 2, 3}, "1, 2 y 3"},
+		{"en", `{{join .value "|"}}`, []string{"a", "b"}, "a|b"},
+		{"en", `{{truncate .value 5}}`, "validator", "valid…"},
+	}
+	for _, test := range tests {
+		messageInput := MessageInput{FieldName: "Field", FieldValue: test.value, Locale: test.locale}
+		if err := templateMessage(test.message, messageInput); err.Error() != test.expected {
+			t.Errorf("\nReceived: %v.\nShould be: %v.\n", err, test.expected)
+		}
+	}
+	if err := AddTemplateFunc("plural", strings.ToUpper); err == nil {
+		t.Log("\nTests the protection of the native functions\n")
+		t.Errorf("\nReceived: nil.\nShould be: an error.\n")
+	}
+	if err := AddTemplateFunc("upper", strings.ToUpper); err != nil {
+		t.Fatalf("\nReceived: %v.\nShould be: nil.\n", err)
+	}
+	defer delete(templateFuncs, "upper")
+	type Person struct {
+		Name string `json:"name" struct-validator:"min:3" struct-validator-msg:"min={{upper .label}} needs {{.ruleValue}} {{plural .ruleValue \"letter\" \"letters\"}}"`
+	}
+	if errorsReceived := Validate(Person{"Al"}, nil); !reflect.DeepEqual(errorsReceived, []error{errors.New("NAME needs 3 letters")}) {
+		t.Log("\nTests a custom function in a message\n")
+		t.Errorf("\nReceived: %v.\nShould be: NAME needs 3 letters.\n", errorsReceived)
+	}
+}
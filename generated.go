@@ -0,0 +1,88 @@
+package validator
+
+import (
+	"reflect"
+	"strings"
+)
+
+// GeneratedValidator - Interface of the structs with the code generated by validator-gen, ValidatorValidate
+// validates the fields with typed code, without reading them by reflection, and returns false when the
+// TagName is not the tag of the generated code. Validate uses it when present, except in the validations
+// with groups, paths, nested structs, previous versions, present keys or Bail, that use reflection.
+type GeneratedValidator interface {
+	ValidatorValidate(validation *GeneratedValidation) bool
+}
+
+// GeneratedValidation - Validation of a struct by the code generated by validator-gen, the generated code
+// checks the rules with the values of the fields and the validation generates the errors with the same
+// messages of Validate
+type GeneratedValidation struct {
+	stValue          reflect.Value
+	opts             options
+	validationErrors ValidationErrors
+	// othersMessageInput - message input of the fields used by the required_with* rules, read once
+	othersMessageInput []MessageInput
+}
+
+// NewGeneratedValidation - Returns the validation of the struct used by the Validate method generated by
+// validator-gen, with the default configuration
+func NewGeneratedValidation(st interface{}) *GeneratedValidation {
+	return &GeneratedValidation{stValue: reflect.ValueOf(st)}
+}
+
+// Check - Validate the value of the field with the rule of the tag, the field and the rule are their
+// indexes in the struct and in the tag. The generated code calls it for the rules without typed checks,
+// like regex, and when the typed check of a rule fails. Returns true if the rule has an error.
+func (validation *GeneratedValidation) Check(field int, rule int, value interface{}) bool {
+	fieldData := getStructFields(validation.stValue.Type())[field]
+	tagRule := getTagRules(fieldData.structField.Tag.Get(TagName))[rule]
+	messageInput := getMessageInput(validation.stValue.Type(), fieldData, value, fieldData.validatorKeyType, validation.opts)
+	messageInput.RuleName, messageInput.RuleValue, messageInput.RuleParams = tagRule.Name, tagRule.Value, tagRule.Params
+	if strings.HasPrefix(tagRule.Name, "required_with") {
+		messageInput.OthersMessageInput = validation.getOthersMessageInput()
+	}
+	if err := types[messageInput.ValidatorKeyType][messageInput.RuleName](messageInput); err != nil {
+		validation.validationErrors = append(validation.validationErrors, newFieldError(messageInput, err))
+		return true
+	}
+	return false
+}
+
+// CheckField - Validate the value of the field with all rules of the tag, like the reflection does. The
+// generated code calls it for the fields with rules unknown at generation time, like the aliases and the
+// custom rules.
+func (validation *GeneratedValidation) CheckField(field int, value interface{}) {
+	fieldData := getStructFields(validation.stValue.Type())[field]
+	tags := fieldData.structField.Tag.Get(TagName)
+	messageInput := getMessageInput(validation.stValue.Type(), fieldData, value, fieldData.validatorKeyType, validation.opts)
+	if skipValidations(tags, validation.stValue.Field(field), messageInput, nil) {
+		return
+	}
+	for _, rule := range getTagRules(tags) {
+		if strings.HasPrefix(rule.Name, "required_with") {
+			messageInput.OthersMessageInput = validation.getOthersMessageInput()
+			break
+		}
+	}
+	validation.validationErrors = append(validation.validationErrors, checkValidations(tags, messageInput, false, false)...)
+}
+
+// Errors - Returns the errors of the rules checked, or nil if there's no error
+func (validation *GeneratedValidation) Errors() ValidationErrors {
+	return validation.validationErrors
+}
+
+// getOthersMessageInput - returns the message input of all fields of the struct, read by reflection only
+// by the required_with* rules
+func (validation *GeneratedValidation) getOthersMessageInput() []MessageInput {
+	if validation.othersMessageInput == nil {
+		validation.othersMessageInput = getMessagesInput(validation.stValue, validation.opts)
+	}
+	return validation.othersMessageInput
+}
+
+// useGenerated - check if the validation can use the code generated by validator-gen, that validates only
+// the rules of the TagName of the fields of the struct
+func (opts options) useGenerated() bool {
+	return !opts.bail && !opts.nested && len(opts.groups) == 0 && opts.selection.include == nil && opts.selection.exclude == nil && !opts.previous.IsValid() && opts.present == nil
+}
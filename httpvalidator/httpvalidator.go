@@ -0,0 +1,147 @@
+// Package httpvalidator - net/http handlers that decode a JSON body, validate it with the validator
+// package and respond with 422 Unprocessable Entity when the body has errors
+package httpvalidator
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/Wandecilenio01/validator"
+)
+
+// DefaultMaxBodyBytes - Maximum size of the body of the requests when Options.MaxBodyBytes is 0, 1 MB
+const DefaultMaxBodyBytes = 1 << 20
+
+// Options - Options of the handlers, a nil Options uses the default values
+type Options struct {
+	// Messages - custom messages passed to the validator
+	Messages map[string]map[string]string
+	// Locale - locale of the messages, when empty the first language of the Accept-Language header of
+	// the request is used
+	Locale string
+	// ProblemRenderer - when not nil, the errors are answered as RFC 7807 Problem Details documents
+	ProblemRenderer *ProblemRenderer
+	// Groups - active validation groups, like "create" in a POST handler and "update" in a PUT handler
+	Groups []string
+	// Normalize - normalize the decoded value with the "normalize" tag before the validation, the value
+	// passed to next is the normalized value
+	Normalize bool
+	// Nested - validate the fields of nested structs, pointers to structs and lists of structs too
+	Nested bool
+	// MaxBodyBytes - maximum size of the body, larger bodies are answered with 413 Request Entity Too
+	// Large. When 0 the DefaultMaxBodyBytes is used and when negative the size is not limited
+	MaxBodyBytes int64
+}
+
+// ErrorResponse - Body of the error responses
+type ErrorResponse struct {
+	Errors []FieldErrorResponse `json:"errors"`
+}
+
+// FieldErrorResponse - One error of the ErrorResponse
+type FieldErrorResponse struct {
+	Field   string `json:"field,omitempty"`
+	Rule    string `json:"rule,omitempty"`
+	Code    string `json:"code,omitempty"`
+	Message string `json:"message"`
+}
+
+// Handler - Returns a handler that decodes the JSON body of the request into a T, validates it and calls
+// next with the decoded value. Invalid JSON bodies are answered with 400 Bad Request, bodies larger than
+// the MaxBodyBytes with 413 Request Entity Too Large and validation errors with 422 Unprocessable Entity.
+func Handler[T any](next func(w http.ResponseWriter, r *http.Request, value T), options *Options) http.Handler {
+	if options == nil {
+		options = &Options{}
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		locale := options.Locale
+		if locale == "" {
+			locale = GetRequestLocale(r)
+		}
+		requestValidator := validator.Validator{Locale: locale, Messages: options.Messages, Groups: options.Groups, Normalize: options.Normalize, Nested: options.Nested}
+		// the keys of the body are used by the "sometimes" modifier
+		var value T
+		var validationErrors validator.ValidationErrors
+		body, err := readBody(w, r, options.MaxBodyBytes)
+		if err == nil {
+			validationErrors, err = requestValidator.ValidateJSON(body, &value)
+		}
+		var maxBytesError *http.MaxBytesError
+		if errors.As(err, &maxBytesError) {
+			if options.ProblemRenderer != nil {
+				options.ProblemRenderer.WriteBodyTooLarge(w, err)
+			} else {
+				WriteJSON(w, http.StatusRequestEntityTooLarge, ErrorResponse{Errors: []FieldErrorResponse{{Message: err.Error()}}})
+			}
+			return
+		} else if err != nil {
+			if options.ProblemRenderer != nil {
+				options.ProblemRenderer.WriteBadRequest(w, err)
+			} else {
+				WriteJSON(w, http.StatusBadRequest, ErrorResponse{Errors: []FieldErrorResponse{{Message: err.Error()}}})
+			}
+			return
+		}
+		if len(validationErrors) > 0 {
+			if !hasOnlyFieldErrors(w, validationErrors) {
+				return
+			} else if options.ProblemRenderer != nil {
+				options.ProblemRenderer.Write(w, validationErrors, locale)
+			} else {
+				WriteValidationErrors(w, validationErrors)
+			}
+			return
+		}
+		next(w, r, value)
+	})
+}
+
+// readBody - read the body of the request, limited to maxBodyBytes, or to the DefaultMaxBodyBytes when
+// it is 0. The error is a *http.MaxBytesError when the body is larger.
+func readBody(w http.ResponseWriter, r *http.Request, maxBodyBytes int64) ([]byte, error) {
+	if maxBodyBytes == 0 {
+		maxBodyBytes = DefaultMaxBodyBytes
+	}
+	if maxBodyBytes > 0 {
+		r.Body = http.MaxBytesReader(w, r.Body, maxBodyBytes)
+	}
+	return io.ReadAll(r.Body)
+}
+
+// hasOnlyFieldErrors - check if all errors are related to a field, errors like a struct without the
+// validator tag are answered with the status 500
+func hasOnlyFieldErrors(w http.ResponseWriter, validationErrors validator.ValidationErrors) bool {
+	for _, fieldError := range validationErrors {
+		if fieldError.RuleName == "" {
+			http.Error(w, fieldError.Error(), http.StatusInternalServerError)
+			return false
+		}
+	}
+	return true
+}
+
+// WriteValidationErrors - Write the validation errors as an ErrorResponse with the status 422
+func WriteValidationErrors(w http.ResponseWriter, validationErrors validator.ValidationErrors) {
+	response := ErrorResponse{Errors: make([]FieldErrorResponse, 0, len(validationErrors))}
+	for _, fieldError := range validationErrors {
+		response.Errors = append(response.Errors, FieldErrorResponse{
+			Field:   fieldError.Path,
+			Rule:    fieldError.RuleName,
+			Code:    fieldError.Code,
+			Message: fieldError.Error(),
+		})
+	}
+	WriteJSON(w, http.StatusUnprocessableEntity, response)
+}
+
+// WriteJSON - Write the body as JSON with the status, the content type is application/json when it
+// was not defined
+func WriteJSON(w http.ResponseWriter, status int, body interface{}) {
+	if w.Header().Get("Content-Type") == "" {
+		w.Header().Set("Content-Type", "application/json")
+	}
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
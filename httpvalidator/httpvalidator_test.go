@@ -0,0 +1,64 @@
+package httpvalidator
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type person struct {
+	Name  string `json:"name" struct-validator:"required"`
+	Email string `json:"email,omitempty" struct-validator:"email"`
+}
+
+func TestHandler(t *testing.T) {
+	t.Log("\nIt tests the responses of the handler\n")
+	handler := Handler(func(w http.ResponseWriter, r *http.Request, value person) {
+		w.Write([]byte(value.Name))
+	}, nil)
+	tests := []struct {
+		body   string
+		status int
+		output string
+	}{
+		{`{"name": "Robert", "email": "robert@gmail.com"}`, http.StatusOK, "Robert"},
+		{`{"name": "", "email": "robert"}`, http.StatusUnprocessableEntity, `{"errors":[{"field":"name","rule":"required","code":"string.required","message":"The name cannot have length less than 1, the informed value was \"\"."},{"field":"email","rule":"email","code":"string.email","message":"The email is not a valid email, the informed value was \"robert\"."}]}` + "\n"},
+		{`{"name": `, http.StatusBadRequest, `{"errors":[{"message":"unexpected EOF"}]}` + "\n"},
+	}
+	for _, test := range tests {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(test.body)))
+		if recorder.Code != test.status || recorder.Body.String() != test.output {
+			t.Log("\nTests the body " + test.body + "\n")
+			t.Errorf("\nReceived: %d %s.\nShould be: %d %s.\n", recorder.Code, recorder.Body.String(), test.status, test.output)
+		}
+	}
+}
+
+func TestHandlerMaxBodyBytes(t *testing.T) {
+	t.Log("\nIt tests that the bodies larger than MaxBodyBytes are answered with 413\n")
+	next := func(w http.ResponseWriter, r *http.Request, value person) {
+		w.Write([]byte(value.Name))
+	}
+	body := `{"name": "Robert"}`
+	tests := []struct {
+		options *Options
+		status  int
+		output  string
+	}{
+		{&Options{MaxBodyBytes: 18}, http.StatusOK, "Robert"},
+		{&Options{MaxBodyBytes: 10}, http.StatusRequestEntityTooLarge, `{"errors":[{"message":"http: request body too large"}]}` + "\n"},
+		{&Options{MaxBodyBytes: 10, ProblemRenderer: &ProblemRenderer{}}, http.StatusRequestEntityTooLarge, `{"type":"about:blank","title":"Request Entity Too Large","status":413,"detail":"http: request body too large"}` + "\n"},
+		{&Options{MaxBodyBytes: -1}, http.StatusOK, "Robert"},
+	}
+	for _, test := range tests {
+		recorder := httptest.NewRecorder()
+		Handler(next, test.options).ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body)))
+		if recorder.Code != test.status || recorder.Body.String() != test.output {
+			t.Log("\nTests the MaxBodyBytes " + fmt.Sprint(test.options.MaxBodyBytes) + "\n")
+			t.Errorf("\nReceived: %d %s.\nShould be: %d %s.\n", recorder.Code, recorder.Body.String(), test.status, test.output)
+		}
+	}
+}
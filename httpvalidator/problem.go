@@ -0,0 +1,137 @@
+package httpvalidator
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/Wandecilenio01/validator"
+)
+
+// ProblemContentType - Content type of the RFC 7807 Problem Details documents
+const ProblemContentType = "application/problem+json"
+
+var (
+	// relation between the array indexes of a path, like items[0], and their pointer, like items/0
+	pathIndexRegex = regexp.MustCompile(`\[(\d+)\]`)
+)
+
+// Problem - RFC 7807 Problem Details document, the Errors are an extension with one item per
+// validation error
+type Problem struct {
+	Type   string         `json:"type"`
+	Title  string         `json:"title"`
+	Status int            `json:"status"`
+	Detail string         `json:"detail,omitempty"`
+	Errors []ProblemError `json:"errors,omitempty"`
+}
+
+// ProblemError - One validation error of the Problem
+type ProblemError struct {
+	Pointer string `json:"pointer"`
+	Rule    string `json:"rule"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// ProblemRenderer - Renderer of validation errors as Problem Details documents
+type ProblemRenderer struct {
+	// BaseTypeURI - Prefix of the problem types, like "https://example.com/problems/", the types are
+	// "validation-error", "invalid-body" and "body-too-large". An empty BaseTypeURI uses "about:blank" as type.
+	BaseTypeURI string
+	// Titles - Titles of the validation problems, indexed by locale, like "pt-BR" or "pt"
+	Titles map[string]string
+}
+
+// DefaultProblemTitle - Title used when there's no title for the locale
+const DefaultProblemTitle = "The request has validation errors."
+
+// Render - Returns the Problem of the validation errors, with the title of the locale
+func (renderer ProblemRenderer) Render(validationErrors validator.ValidationErrors, locale string) Problem {
+	problem := Problem{
+		Type:   renderer.typeURI("validation-error"),
+		Title:  renderer.title(locale),
+		Status: http.StatusUnprocessableEntity,
+		Errors: make([]ProblemError, 0, len(validationErrors)),
+	}
+	messages := make([]string, 0, len(validationErrors))
+	for _, fieldError := range validationErrors {
+		messages = append(messages, fieldError.Error())
+		problem.Errors = append(problem.Errors, ProblemError{
+			Pointer: GetJSONPointer(fieldError.Path),
+			Rule:    fieldError.RuleName,
+			Code:    fieldError.Code,
+			Message: fieldError.Error(),
+		})
+	}
+	problem.Detail = strings.Join(messages, " ")
+	return problem
+}
+
+// Write - Write the Problem of the validation errors, with the title of the locale, like the locale of
+// the messages or the GetRequestLocale of the request
+func (renderer ProblemRenderer) Write(w http.ResponseWriter, validationErrors validator.ValidationErrors, locale string) {
+	WriteProblem(w, renderer.Render(validationErrors, locale))
+}
+
+// WriteBadRequest - Write a Problem with the status 400 and the error as detail
+func (renderer ProblemRenderer) WriteBadRequest(w http.ResponseWriter, err error) {
+	WriteProblem(w, Problem{
+		Type:   renderer.typeURI("invalid-body"),
+		Title:  http.StatusText(http.StatusBadRequest),
+		Status: http.StatusBadRequest,
+		Detail: err.Error(),
+	})
+}
+
+// WriteBodyTooLarge - Write a Problem with the status 413 and the error as detail
+func (renderer ProblemRenderer) WriteBodyTooLarge(w http.ResponseWriter, err error) {
+	WriteProblem(w, Problem{
+		Type:   renderer.typeURI("body-too-large"),
+		Title:  http.StatusText(http.StatusRequestEntityTooLarge),
+		Status: http.StatusRequestEntityTooLarge,
+		Detail: err.Error(),
+	})
+}
+
+// typeURI - Returns the type of the problem using the BaseTypeURI
+func (renderer ProblemRenderer) typeURI(problemType string) string {
+	if renderer.BaseTypeURI == "" {
+		return "about:blank"
+	}
+	return renderer.BaseTypeURI + problemType
+}
+
+// title - Returns the title of the first locale of the validator.GetLocaleChain with a title, or the
+// default title
+func (renderer ProblemRenderer) title(locale string) string {
+	for _, chainLocale := range validator.GetLocaleChain(locale) {
+		if title, ok := renderer.Titles[chainLocale]; ok {
+			return title
+		}
+	}
+	return DefaultProblemTitle
+}
+
+// WriteProblem - Write the Problem with its status and the Problem Details content type
+func WriteProblem(w http.ResponseWriter, problem Problem) {
+	w.Header().Set("Content-Type", ProblemContentType)
+	WriteJSON(w, problem.Status, problem)
+}
+
+// GetJSONPointer - Returns the JSON pointer of a field path, like /address/zip for address.zip or
+// /items/0/sku for items[0].sku
+func GetJSONPointer(path string) string {
+	if path == "" {
+		return ""
+	}
+	path = strings.NewReplacer("~", "~0", "/", "~1").Replace(path)
+	return "/" + strings.Replace(pathIndexRegex.ReplaceAllString(path, ".$1"), ".", "/", -1)
+}
+
+// GetRequestLocale - Returns the first language of the Accept-Language header, like pt-BR for
+// "pt-BR,pt;q=0.9,en;q=0.8"
+func GetRequestLocale(r *http.Request) string {
+	locale := strings.Split(r.Header.Get("Accept-Language"), ",")[0]
+	return strings.TrimSpace(strings.Split(locale, ";")[0])
+}
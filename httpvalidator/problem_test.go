@@ -0,0 +1,53 @@
+package httpvalidator
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Wandecilenio01/validator"
+)
+
+func TestProblemRenderer(t *testing.T) {
+	t.Log("\nIt tests the Problem Details of the validation errors\n")
+	renderer := ProblemRenderer{BaseTypeURI: "https://example.com/problems/", Titles: map[string]string{"pt": "A requisição possui erros de validação."}}
+	validationErrors := validator.ValidationErrors{
+		{FieldName: "Zip", Path: "address.zip", RuleName: "length", Code: "string.length", Err: errors.New("Invalid zip.")},
+		{FieldName: "SKU", Path: "items[1].sku", RuleName: "required", Code: "string.required", Err: errors.New("Invalid sku.")},
+	}
+	expected := Problem{
+		Type:   "https://example.com/problems/validation-error",
+		Title:  "A requisição possui erros de validação.",
+		Status: http.StatusUnprocessableEntity,
+		Detail: "Invalid zip. Invalid sku.",
+		Errors: []ProblemError{{"/address/zip", "length", "string.length", "Invalid zip."}, {"/items/1/sku", "required", "string.required", "Invalid sku."}},
+	}
+	if problem := renderer.Render(validationErrors, "pt-BR"); !reflect.DeepEqual(problem, expected) {
+		t.Errorf("\nReceived: %+v.\nShould be: %+v.\n", problem, expected)
+	}
+	if problem := renderer.Render(validationErrors, "es"); problem.Title != DefaultProblemTitle {
+		t.Log("\nTests the default title\n")
+		t.Errorf("\nReceived: %v.\nShould be: %v.\n", problem.Title, DefaultProblemTitle)
+	}
+	handler := Handler(func(w http.ResponseWriter, r *http.Request, value person) {}, &Options{ProblemRenderer: &renderer})
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"name": ""}`))
+	request.Header.Set("Accept-Language", "pt-BR,pt;q=0.9")
+	handler.ServeHTTP(recorder, request)
+	output := `{"type":"https://example.com/problems/validation-error","title":"A requisição possui erros de validação.","status":422,"detail":"O campo name deve ter pelo menos 1 caractere, o valor informado foi \"\".","errors":[{"pointer":"/name","rule":"required","code":"string.required","message":"O campo name deve ter pelo menos 1 caractere, o valor informado foi \"\"."}]}` + "\n"
+	if recorder.Header().Get("Content-Type") != ProblemContentType || recorder.Body.String() != output {
+		t.Log("\nTests the handler with the renderer\n")
+		t.Errorf("\nReceived: %s %s.\nShould be: %s %s.\n", recorder.Header().Get("Content-Type"), recorder.Body.String(), ProblemContentType, output)
+	}
+	handler = Handler(func(w http.ResponseWriter, r *http.Request, value person) {}, &Options{Locale: "pt-BR", ProblemRenderer: &renderer})
+	recorder = httptest.NewRecorder()
+	request = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"name": ""}`))
+	handler.ServeHTTP(recorder, request)
+	if recorder.Body.String() != output {
+		t.Log("\nTests the title with the locale of the options\n")
+		t.Errorf("\nReceived: %s.\nShould be: %s.\n", recorder.Body.String(), output)
+	}
+}
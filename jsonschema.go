@@ -0,0 +1,343 @@
+package validator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Rule - One rule applied to a field, like the parts of the tag "min:3|max:20"
+type Rule struct {
+	Name  string
+	Value string
+}
+
+// FieldRules - Rules applied to one field of a RuleSet
+type FieldRules struct {
+	Name             string
+	ValidatorKeyType string
+	// Type - The JSON type expected for the field: string, number, integer, boolean, array or object
+	Type     string
+	Rules    []Rule
+	Required bool
+	// Fields - The properties of an object field
+	Fields []*FieldRules
+	// Items - The rules applied to each item of an array field
+	Items *FieldRules
+}
+
+// RuleSet - Set of field rules that can validate a map[string]interface{} or a struct
+type RuleSet struct {
+	Fields []*FieldRules
+}
+
+var (
+	// relation between JSON Schema types and 'validator key types'
+	jsonSchemaValidatorKeyTypes = map[string]string{
+		"string":  "string",
+		"number":  "numeric",
+		"integer": "numeric",
+		"array":   "array",
+		"boolean": "",
+		"object":  "",
+	}
+	// relation between JSON Schema formats and string rules
+	jsonSchemaFormats = map[string]string{
+		"email": "email",
+		"uri":   "url",
+		"url":   "url",
+		"ipv4":  "ipv4",
+	}
+	// keywords without effect in the validation
+	jsonSchemaAnnotations = map[string]bool{
+		"$schema":     true,
+		"$id":         true,
+		"id":          true,
+		"$comment":    true,
+		"title":       true,
+		"description": true,
+		"default":     true,
+		"examples":    true,
+	}
+)
+
+// LoadJSONSchemaFile - Read a JSON Schema file and returns the RuleSet that represents it
+func LoadJSONSchemaFile(path string) (*RuleSet, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return LoadJSONSchema(file)
+}
+
+// LoadJSONSchema - Read a JSON Schema document and returns the RuleSet that represents it, an error is
+// returned if the document uses keywords that cannot be represented with the validator rules
+func LoadJSONSchema(r io.Reader) (*RuleSet, error) {
+	var schema map[string]interface{}
+	if err := json.NewDecoder(r).Decode(&schema); err != nil {
+		return nil, fmt.Errorf("Error: The JSON Schema is not a valid JSON object: %v", err)
+	}
+	if schemaType, ok := schema["type"]; ok && schemaType != "object" {
+		return nil, fmt.Errorf("Error: The root of the JSON Schema have to be an object, the type informed was %v", schemaType)
+	}
+	schema["type"] = "object"
+	unsupported := make([]string, 0)
+	root := parseJSONSchema("", "#", schema, &unsupported)
+	if len(unsupported) > 0 {
+		return nil, fmt.Errorf("Error: The JSON Schema has unsupported keywords: %s", strings.Join(unsupported, ", "))
+	}
+	return &RuleSet{Fields: root.Fields}, nil
+}
+
+// parseJSONSchema - convert one schema node to FieldRules, the keywords that cannot be converted are
+// appended to unsupported as JSON pointers
+func parseJSONSchema(name string, pointer string, schema map[string]interface{}, unsupported *[]string) *FieldRules {
+	fieldRules := &FieldRules{Name: name}
+	if schemaType, ok := schema["type"].(string); ok {
+		if validatorKeyType, exists := jsonSchemaValidatorKeyTypes[schemaType]; exists {
+			fieldRules.Type = schemaType
+			fieldRules.ValidatorKeyType = validatorKeyType
+		} else {
+			*unsupported = append(*unsupported, pointer+"/type")
+		}
+	} else if _, ok := schema["type"]; ok {
+		*unsupported = append(*unsupported, pointer+"/type")
+	}
+	keywords := make([]string, 0, len(schema))
+	for keyword := range schema {
+		keywords = append(keywords, keyword)
+	}
+	sort.Strings(keywords)
+	for _, keyword := range keywords {
+		value := schema[keyword]
+		switch {
+		case keyword == "type" || jsonSchemaAnnotations[keyword]:
+			continue
+		case fieldRules.Type == "string" && (keyword == "minLength" || keyword == "maxLength"):
+			if ruleValue, ok := getJSONSchemaUint(value); ok {
+				// the lengths of JSON Schema are counted in characters
+				fieldRules.Rules = append(fieldRules.Rules, Rule{keyword[:3] + "_chars", ruleValue})
+				continue
+			}
+		case fieldRules.Type == "string" && keyword == "pattern":
+			// the patterns that Go cannot compile, like lookaheads, are unsupported
+			if ruleValue, ok := value.(string); ok {
+				if _, err := regexp.Compile(ruleValue); err == nil {
+					fieldRules.Rules = append(fieldRules.Rules, Rule{"regex", ruleValue})
+					continue
+				}
+			}
+		case fieldRules.Type == "string" && keyword == "format":
+			if ruleName, ok := jsonSchemaFormats[fmt.Sprint(value)]; ok {
+				fieldRules.Rules = append(fieldRules.Rules, Rule{Name: ruleName})
+				continue
+			}
+		case fieldRules.ValidatorKeyType == "numeric" && (keyword == "minimum" || keyword == "maximum"):
+			if ruleValue, ok := value.(float64); ok {
+				fieldRules.Rules = append(fieldRules.Rules, Rule{keyword[:3], strconv.FormatFloat(ruleValue, 'f', -1, 64)})
+				continue
+			}
+		case fieldRules.Type == "array" && (keyword == "minItems" || keyword == "maxItems"):
+			if ruleValue, ok := getJSONSchemaUint(value); ok {
+				fieldRules.Rules = append(fieldRules.Rules, Rule{keyword[:3], ruleValue})
+				continue
+			}
+		case fieldRules.Type == "array" && keyword == "uniqueItems":
+			if ruleValue, ok := value.(bool); ok {
+				if ruleValue {
+					fieldRules.Rules = append(fieldRules.Rules, Rule{Name: "distinct"})
+				}
+				continue
+			}
+		case fieldRules.Type == "array" && keyword == "items":
+			if itemSchema, ok := value.(map[string]interface{}); ok {
+				fieldRules.Items = parseJSONSchema("", pointer+"/items", itemSchema, unsupported)
+				continue
+			}
+		case fieldRules.Type == "object" && keyword == "properties":
+			if properties, ok := value.(map[string]interface{}); ok && parseJSONSchemaProperties(fieldRules, pointer, properties, unsupported) {
+				continue
+			}
+		case fieldRules.Type == "object" && keyword == "required":
+			// applied after the properties
+			continue
+		case fieldRules.Type == "object" && keyword == "additionalProperties":
+			if ruleValue, ok := value.(bool); ok && ruleValue {
+				continue
+			}
+		}
+		*unsupported = append(*unsupported, pointer+"/"+keyword)
+	}
+	if required, ok := schema["required"]; ok && fieldRules.Type == "object" {
+		requiredNames, ok := required.([]interface{})
+		if !ok {
+			*unsupported = append(*unsupported, pointer+"/required")
+		}
+		for _, requiredName := range requiredNames {
+			found := false
+			for _, field := range fieldRules.Fields {
+				if field.Name == requiredName {
+					field.Required, found = true, true
+				}
+			}
+			if !found {
+				// a required property without schema accepts any value
+				fieldRules.Fields = append(fieldRules.Fields, &FieldRules{Name: fmt.Sprint(requiredName), Required: true})
+			}
+		}
+	}
+	return fieldRules
+}
+
+// parseJSONSchemaProperties - convert the properties of an object schema, returns false if some
+// property is not a schema object
+func parseJSONSchemaProperties(fieldRules *FieldRules, pointer string, properties map[string]interface{}, unsupported *[]string) bool {
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		propertySchema, ok := properties[name].(map[string]interface{})
+		if !ok {
+			return false
+		}
+		fieldRules.Fields = append(fieldRules.Fields, parseJSONSchema(name, pointer+"/properties/"+name, propertySchema, unsupported))
+	}
+	return true
+}
+
+// getJSONSchemaUint - returns the uint string of a JSON number, and false if it is not an uint
+func getJSONSchemaUint(value interface{}) (string, bool) {
+	if number, ok := value.(float64); ok && number >= 0 && number == math.Trunc(number) {
+		return strconv.FormatUint(uint64(number), 10), true
+	}
+	return "", false
+}
+
+// Validate - Validate a map[string]interface{} or a struct using the rules of the RuleSet, structs are
+// converted to maps using the "json" tags
+func (ruleSet *RuleSet) Validate(data interface{}, messages map[string]map[string]string) (returnedErrors []error) {
+	if data == nil {
+		return append(returnedErrors, errors.New("The interface passed is nil"))
+	}
+	values, ok := data.(map[string]interface{})
+	if !ok {
+		// decoded structs are converted to the same representation of a decoded JSON
+		fieldValueJSONString, err := json.Marshal(data)
+		if err != nil {
+			return append(returnedErrors, err)
+		} else if err = json.Unmarshal(fieldValueJSONString, &values); err != nil {
+			return append(returnedErrors, err)
+		}
+	}
+	return validateRuleSetFields(ruleSet.Fields, "", values, messages)
+}
+
+// validateRuleSetFields - validate the values of one object using the fields rules
+func validateRuleSetFields(fields []*FieldRules, path string, values map[string]interface{}, messages map[string]map[string]string) (returnedErrors []error) {
+	messagesInput := make([]MessageInput, len(fields))
+	for i, field := range fields {
+		messagesInput[i] = MessageInput{
+			FieldName:        path + field.Name,
+			FieldValue:       values[field.Name],
+			CustomMessages:   messages,
+			Sensitive:        isSensitiveName(field.Name),
+			ValidatorKeyType: field.ValidatorKeyType,
+		}
+		if values[field.Name] != nil {
+			messagesInput[i].FieldType = reflect.TypeOf(values[field.Name])
+		}
+	}
+	// the required_with* rules use the names of the fields in the object, without the path
+	siblingsMessageInput := make([]MessageInput, len(fields))
+	for i, field := range fields {
+		siblingsMessageInput[i] = messagesInput[i]
+		siblingsMessageInput[i].FieldName = field.Name
+	}
+	for i, field := range fields {
+		messagesInput[i].OthersMessageInput = siblingsMessageInput
+		if _, present := values[field.Name]; !present {
+			if field.Required {
+				messageInput := messagesInput[i]
+				messageInput.ValidatorKeyType, messageInput.RuleName = "schema", "required"
+				returnedErrors = append(returnedErrors, GenerateErrorMessage(messageInput))
+			}
+			continue
+		}
+		returnedErrors = append(returnedErrors, field.validate(messagesInput[i])...)
+	}
+	return returnedErrors
+}
+
+// validate - check the type of the value and apply the rules of the field
+func (field *FieldRules) validate(messageInput MessageInput) (returnedErrors []error) {
+	if !isJSONSchemaType(field.Type, messageInput.FieldValue) {
+		messageInput.ValidatorKeyType, messageInput.RuleName, messageInput.RuleValue = "schema", "type", field.Type
+		return append(returnedErrors, GenerateErrorMessage(messageInput))
+	}
+	for _, rule := range field.Rules {
+		messageInput.RuleName, messageInput.RuleValue = rule.Name, rule.Value
+		if err := types[messageInput.ValidatorKeyType][rule.Name](messageInput); err != nil {
+			returnedErrors = append(returnedErrors, err)
+		}
+	}
+	switch fieldValue := messageInput.FieldValue.(type) {
+	case map[string]interface{}:
+		returnedErrors = append(returnedErrors, validateRuleSetFields(field.Fields, messageInput.FieldName+".", fieldValue, messageInput.CustomMessages)...)
+	case []interface{}:
+		if field.Items == nil {
+			break
+		}
+		for i, item := range fieldValue {
+			itemMessageInput := messageInput
+			itemMessageInput.FieldName = fmt.Sprintf("%s[%d]", messageInput.FieldName, i)
+			itemMessageInput.FieldValue = item
+			itemMessageInput.FieldType = nil
+			if item != nil {
+				itemMessageInput.FieldType = reflect.TypeOf(item)
+			}
+			itemMessageInput.ValidatorKeyType = field.Items.ValidatorKeyType
+			itemMessageInput.OthersMessageInput = nil
+			returnedErrors = append(returnedErrors, field.Items.validate(itemMessageInput)...)
+		}
+	}
+	return returnedErrors
+}
+
+// isJSONSchemaType - check if a decoded JSON value is of the JSON Schema type, an empty type accepts
+// any value
+func isJSONSchemaType(schemaType string, value interface{}) bool {
+	switch schemaType {
+	case "":
+		return true
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "number":
+		_, ok := value.(float64)
+		return ok
+	case "integer":
+		number, ok := value.(float64)
+		return ok && number == math.Trunc(number)
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "array":
+		_, ok := value.([]interface{})
+		return ok
+	case "object":
+		_, ok := value.(map[string]interface{})
+		return ok
+	}
+	return false
+}
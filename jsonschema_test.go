@@ -0,0 +1,109 @@
+package validator
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var (
+	jsonSchemaTest = `{
+		"$schema": "http://json-schema.org/draft-07/schema#",
+		"title": "Person",
+		"type": "object",
+		"required": ["name", "age"],
+		"properties": {
+			"name": {"type": "string", "minLength": 3, "maxLength": 20},
+			"email": {"type": "string", "format": "email"},
+			"age": {"type": "integer", "minimum": 18},
+			"tags": {"type": "array", "uniqueItems": true, "items": {"type": "string", "pattern": "^[a-z]*$"}},
+			"address": {"type": "object", "required": ["zip"], "properties": {"zip": {"type": "string", "minLength": 8}}}
+		}
+	}`
+)
+
+func TestLoadJSONSchema(t *testing.T) {
+	t.Log("\nIt tests if the unsupported keywords are reported at load time\n")
+	_, err := LoadJSONSchema(strings.NewReader(`{"type": "object", "properties": {"code": {"type": "string", "enum": ["a", "b"]}, "slug": {"type": "string", "pattern": "^(?=x)"}, "age": {"type": "number", "exclusiveMinimum": 3}}}`))
+	if err == nil || err.Error() != "Error: The JSON Schema has unsupported keywords: #/properties/age/exclusiveMinimum, #/properties/code/enum, #/properties/slug/pattern" {
+		t.Errorf("\nReceived: %v.\nShould be: the unsupported keywords.\n", err)
+	}
+	ruleSet, err := LoadJSONSchema(strings.NewReader(jsonSchemaTest))
+	if err != nil {
+		t.Fatalf("\nReceived: %v.\nShould be: nil.\n", err)
+	}
+	if len(ruleSet.Fields) != 5 || !reflect.DeepEqual(ruleSet.Fields[3].Rules, []Rule{{"max_chars", "20"}, {"min_chars", "3"}}) || !ruleSet.Fields[3].Required {
+		t.Log("\nTests the rules of the name property\n")
+		t.Errorf("\nReceived: %+v.\nShould be: the min and max rules.\n", ruleSet.Fields[3])
+	}
+}
+
+func TestRuleSetValidate(t *testing.T) {
+	t.Log("\nIt tests the validation of maps and structs using a JSON Schema\n")
+	ruleSet, err := LoadJSONSchema(strings.NewReader(jsonSchemaTest))
+	if err != nil {
+		t.Fatalf("\nReceived: %v.\nShould be: nil.\n", err)
+	}
+	data := map[string]interface{}{
+		"name":    "Jo",
+		"email":   "jo",
+		"tags":    []interface{}{"go", "Go", "go"},
+		"address": map[string]interface{}{},
+	}
+	errorsExpected := []error{
+		errors.New("The address.zip is required."),
+		errors.New("The age is required."),
+		errors.New(`The email is not a valid email, the informed value was "jo".`),
+		errors.New(`The name cannot have less than 3 characters, the informed value was "Jo".`),
+		errors.New("The tags cannot have to be distinct and cannot have repeated itens, the value informed was [go Go go]."),
+		errors.New("The tags[1] is not a valid regex:^[a-z]*$ , the informed value was Go."),
+	}
+	if errorsReceived := ruleSet.Validate(data, nil); !reflect.DeepEqual(errorsReceived, errorsExpected) {
+		t.Log("\nTests a map with errors\n")
+		t.Errorf("\nReceived: %v.\nShould be: %v.\n", errorsReceived, errorsExpected)
+	}
+	type Person struct {
+		Name string   `json:"name"`
+		Age  float64  `json:"age"`
+		Tags []string `json:"tags,omitempty"`
+	}
+	if errorsReceived := ruleSet.Validate(Person{"Robert", 17.5, nil}, nil); !reflect.DeepEqual(errorsReceived, []error{errors.New("The age have to be of type integer, the value informed was 17.5.")}) {
+		t.Log("\nTests a decoded struct\n")
+		t.Errorf("\nReceived: %v.\nShould be: the type error.\n", errorsReceived)
+	}
+	if errorsReceived := ruleSet.Validate(Person{"Robert", 18, []string{"go"}}, nil); errorsReceived != nil {
+		t.Log("\nTests a decoded struct with no errors\n")
+		t.Errorf("\nReceived: %v.\nShould be: nil.\n", errorsReceived)
+	}
+	if errorsReceived := ruleSet.Validate(Person{"João da Conceição Sá", 18, nil}, nil); errorsReceived != nil {
+		t.Log("\nTests the lengths counted in characters\n")
+		t.Errorf("\nReceived: %v.\nShould be: nil.\n", errorsReceived)
+	}
+}
+
+func TestRuleSetValidateItemsType(t *testing.T) {
+	t.Log("\nIt tests if the rules of the items receive the type of the item instead of the type of the list\n")
+	var fieldTypes []reflect.Type
+	err := AddCustomValidator("string", "item_type", func(messageInput MessageInput) error {
+		fieldTypes = append(fieldTypes, messageInput.FieldType)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("\nReceived: %v.\nShould be: nil.\n", err)
+	}
+	t.Cleanup(func() {
+		if err := DelCustomValidator("string", "item_type"); err != nil {
+			t.Errorf("\nReceived: %v.\nShould be: nil.\n", err)
+		}
+	})
+	ruleSet := &RuleSet{Fields: []*FieldRules{{
+		Name:  "tags",
+		Type:  "array",
+		Items: &FieldRules{Type: "string", ValidatorKeyType: "string", Rules: []Rule{{Name: "item_type"}}},
+	}}}
+	ruleSet.Validate(map[string]interface{}{"tags": []interface{}{"go"}}, nil)
+	if expected := []reflect.Type{reflect.TypeOf("")}; !reflect.DeepEqual(fieldTypes, expected) {
+		t.Errorf("\nReceived: %v.\nShould be: %v.\n", fieldTypes, expected)
+	}
+}
@@ -0,0 +1,138 @@
+package validator
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	// DefaultLocale - Locale used when the validation has no locale, the "en" messages are the native
+	// messages
+	DefaultLocale = "en"
+	// catalogs - relation between 'locale' and 'validator key type' and 'rule' and 'message'
+	catalogs = make(map[string]map[string]map[string]string)
+	// labels - relation between 'locale' and field names and labels
+	labels = make(map[string]map[string]string)
+)
+
+// AddMessages - Add the messages of a locale, like "pt-BR", the messages already defined for the locale
+// are kept when they are not in the new messages. This function should be called before the validations.
+func AddMessages(locale string, messages map[string]map[string]string) {
+	locale = normalizeLocale(locale)
+	if catalogs[locale] == nil {
+		catalogs[locale] = make(map[string]map[string]string)
+	}
+	for validatorKeyType, rulesMessages := range messages {
+		if catalogs[locale][validatorKeyType] == nil {
+			catalogs[locale][validatorKeyType] = make(map[string]string)
+		}
+		for ruleName, message := range rulesMessages {
+			catalogs[locale][validatorKeyType][ruleName] = message
+		}
+	}
+}
+
+// AddLabels - Add the labels of the fields for a locale, the keys can be the struct and field names, like
+// "Person.CreateAt", the field name, like "CreateAt", or the name in the "json" tag, like "createAt".
+// This function should be called before the validations.
+func AddLabels(locale string, fieldsLabels map[string]string) {
+	locale = normalizeLocale(locale)
+	if labels[locale] == nil {
+		labels[locale] = make(map[string]string)
+	}
+	for field, label := range fieldsLabels {
+		labels[locale][field] = label
+	}
+}
+
+// LoadMessages - Read the messages of a locale in the "json" or "yaml" format, with the same structure
+// of the native messages, and add them like AddMessages
+func LoadMessages(locale string, r io.Reader, format string) error {
+	messages := make(map[string]map[string]string)
+	switch format {
+	case "json":
+		if err := json.NewDecoder(r).Decode(&messages); err != nil {
+			return fmt.Errorf("Error: The messages of %s are not valid: %v", locale, err)
+		}
+	case "yaml", "yml":
+		if err := yaml.NewDecoder(r).Decode(&messages); err != nil && err != io.EOF {
+			return fmt.Errorf("Error: The messages of %s are not valid: %v", locale, err)
+		}
+	default:
+		return fmt.Errorf("Error: The format %s is not supported, use json or yaml", format)
+	}
+	AddMessages(locale, messages)
+	return nil
+}
+
+// LoadMessagesFile - Read a messages file of a locale, the format is chosen by the file extension
+// (.json, .yaml or .yml)
+func LoadMessagesFile(locale string, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return LoadMessages(locale, file, strings.TrimPrefix(filepath.Ext(path), "."))
+}
+
+// GetLocaleChain - Returns the locales used to find the messages of a locale, from the most specific to
+// "en", like pt-BR, pt and en. An empty locale uses the DefaultLocale.
+func GetLocaleChain(locale string) []string {
+	if locale = normalizeLocale(locale); locale == "" {
+		locale = normalizeLocale(DefaultLocale)
+	}
+	chain := make([]string, 0, 3)
+	for locale != "" {
+		chain = append(chain, locale)
+		if index := strings.LastIndex(locale, "-"); index > 0 {
+			locale = locale[:index]
+		} else {
+			locale = ""
+		}
+	}
+	if len(chain) == 0 || chain[len(chain)-1] != "en" {
+		chain = append(chain, "en")
+	}
+	return chain
+}
+
+// getLocaleMessages - returns the first messages of the locale chain with a message for the 'validator
+// key type' and 'rule', or the native messages if no locale has that message
+func getLocaleMessages(locale string, validatorKeyType string, ruleName string) map[string]map[string]string {
+	for _, chainLocale := range GetLocaleChain(locale) {
+		if catalogs[chainLocale][validatorKeyType][ruleName] != "" {
+			return catalogs[chainLocale]
+		}
+	}
+	return nativeMessages
+}
+
+// getFieldLabel - returns the label of the field, searched in the labels of the locale chain, then in
+// the "label" tag, then in the "json" tag, or the field name
+func getFieldLabel(structType reflect.Type, structField reflect.StructField, locale string) string {
+	jsonName := getJSONName(structField)
+	for _, chainLocale := range GetLocaleChain(locale) {
+		for _, key := range []string{structType.Name() + "." + structField.Name, structField.Name, jsonName} {
+			if label, ok := labels[chainLocale][key]; ok {
+				return label
+			}
+		}
+	}
+	if label := structField.Tag.Get("label"); label != "" {
+		return label
+	}
+	return jsonName
+}
+
+// normalizeLocale - use '-' as separator of the locale, like pt-BR for pt_BR
+func normalizeLocale(locale string) string {
+	return strings.Replace(strings.TrimSpace(locale), "_", "-", -1)
+}
@@ -0,0 +1,81 @@
+package validator
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestGetLocaleChain(t *testing.T) {
+	t.Log("\nIt tests the fallback chain of the locales\n")
+	tests := map[string][]string{
+		"pt-BR": []string{"pt-BR", "pt", "en"},
+		"pt_BR": []string{"pt-BR", "pt", "en"},
+		"es":    []string{"es", "en"},
+		"es-AR": []string{"es-AR", "es", "en"},
+		"en-US": []string{"en-US", "en"},
+		"":      []string{"en"},
+	}
+	for locale, expected := range tests {
+		if chain := GetLocaleChain(locale); !reflect.DeepEqual(chain, expected) {
+			t.Errorf("\nReceived: %v.\nShould be: %v.\n", chain, expected)
+		}
+	}
+}
+
+func TestValidateWithLocale(t *testing.T) {
+	type Person struct {
+		Name string `json:"name" struct-validator:"required"`
+		Age  int64  `json:"age" struct-validator:"min:18"`
+	}
+	t.Log("\nIt tests the messages of the locales\n")
+	expected := []error{errors.New(`O campo name deve ter pelo menos 1 caractere, o valor informado foi "".`), errors.New("O campo age não pode ser menor que 18, o valor informado foi 10.")}
+	if errorsReceived := ValidateWithLocale(Person{"", 10}, "pt-BR", nil); !reflect.DeepEqual(errorsReceived, expected) {
+		t.Errorf("\nReceived: %v.\nShould be: %v.\n", errorsReceived, expected)
+	}
+	expected = []error{errors.New("El campo age no puede ser menor que 18, el valor informado fue 10.")}
+	if errorsReceived := (&Validator{Locale: "es-AR"}).Validate(Person{"Juan", 10}); !reflect.DeepEqual(errorsReceived, expected) {
+		t.Log("\nTests the fallback from es-AR to es\n")
+		t.Errorf("\nReceived: %v.\nShould be: %v.\n", errorsReceived, expected)
+	}
+	expected = []error{errors.New("O campo age não pode ser menor que 18, o valor informado foi 10.")}
+	for _, locale := range []string{"pt", "pt-PT"} {
+		if errorsReceived := ValidateWithLocale(Person{"João", 10}, locale, nil); !reflect.DeepEqual(errorsReceived, expected) {
+			t.Log("\nTests the native messages of pt and pt-PT\n")
+			t.Errorf("\nReceived: %v.\nShould be: %v.\n", errorsReceived, expected)
+		}
+	}
+	if err := LoadMessages("pt", strings.NewReader("numeric:\n  min: \"{{.fieldName}} deve ser pelo menos {{.ruleValue}}.\"\n"), "yaml"); err != nil {
+		t.Fatalf("\nReceived: %v.\nShould be: nil.\n", err)
+	}
+	defer func(message string) {
+		catalogs["pt"]["numeric"]["min"] = message
+	}(catalogs["pt"]["numeric"]["min"])
+	expected = []error{errors.New("Age deve ser pelo menos 18.")}
+	if errorsReceived := ValidateWithLocale(Person{"João", 10}, "pt-PT", nil); !reflect.DeepEqual(errorsReceived, expected) {
+		t.Log("\nTests the messages loaded from YAML with the fallback from pt-PT to pt\n")
+		t.Errorf("\nReceived: %v.\nShould be: %v.\n", errorsReceived, expected)
+	}
+}
+
+func TestFieldLabels(t *testing.T) {
+	type Event struct {
+		CreateAt string `json:"createAt" label:"Data de criação" struct-validator:"required"`
+		Title    string `json:"title" struct-validator:"required"`
+		Place    string `struct-validator:"required"`
+	}
+	messages := map[string]map[string]string{"*": map[string]string{"min": "{{.label}} ({{.fieldName}})"}}
+	t.Log("\nIt tests the labels of the tag, of the locale and of the json name\n")
+	expected := []error{errors.New("Data de criação (CreateAt)"), errors.New("title (Title)"), errors.New("Place (Place)")}
+	if errorsReceived := Validate(Event{}, messages); !reflect.DeepEqual(errorsReceived, expected) {
+		t.Errorf("\nReceived: %v.\nShould be: %v.\n", errorsReceived, expected)
+	}
+	AddLabels("es", map[string]string{"Event.CreateAt": "Fecha de creación", "title": "Título"})
+	defer delete(labels, "es")
+	expected = []error{errors.New("Fecha de creación (CreateAt)"), errors.New("Título (Title)"), errors.New("Place (Place)")}
+	if errorsReceived := ValidateWithLocale(Event{}, "es-AR", messages); !reflect.DeepEqual(errorsReceived, expected) {
+		t.Log("\nTests the labels of the locale\n")
+		t.Errorf("\nReceived: %v.\nShould be: %v.\n", errorsReceived, expected)
+	}
+}
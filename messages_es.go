@@ -0,0 +1,71 @@
+package validator
+
+// definition of the es messages
+func init() {
+	requiredMessages := map[string]string{
+		"required_with":        "El campo {{.label}} es obligatorio cuando al menos uno de los campos ({{.ruleValue}}) está completo.",
+		"required_with_all":    "El campo {{.label}} es obligatorio cuando todos los campos ({{.ruleValue}}) están completos.",
+		"required_without":     "El campo {{.label}} es obligatorio cuando al menos uno de los campos ({{.ruleValue}}) no está completo.",
+		"required_without_all": "El campo {{.label}} es obligatorio cuando ninguno de los campos ({{.ruleValue}}) está completo.",
+	}
+	messages := map[string]map[string]string{
+		"numeric": map[string]string{
+			"min":        "El campo {{.label}} no puede ser menor que {{number .ruleValue}}, el valor informado fue {{number .value}}.",
+			"max":        "El campo {{.label}} no puede ser mayor que {{number .ruleValue}}, el valor informado fue {{number .value}}.",
+			"immutable":  "El campo {{.label}} no puede ser modificado, el valor anterior era {{number .previousValue}} y el valor informado fue {{number .value}}.",
+			"increasing": "El campo {{.label}} no puede ser menor que el valor anterior {{number .previousValue}}, el valor informado fue {{number .value}}.",
+		},
+		"array": map[string]string{
+			"min":       "El campo {{.label}} debe tener al menos {{.ruleValue}} {{plural .ruleValue \"elemento\" \"elementos\"}}, el valor informado fue {{list .value}}.",
+			"max":       "El campo {{.label}} debe tener como máximo {{.ruleValue}} {{plural .ruleValue \"elemento\" \"elementos\"}}, el valor informado fue {{list .value}}.",
+			"distinct":  "El campo {{.label}} no puede tener elementos repetidos, el valor informado fue {{list .value}}.",
+			"immutable": "El campo {{.label}} no puede ser modificado, el valor anterior era {{list .previousValue}} y el valor informado fue {{list .value}}.",
+			"no_shrink": "El campo {{.label}} no puede tener menos elementos que el valor anterior {{list .previousValue}}, el valor informado fue {{list .value}}.",
+		},
+		"string": map[string]string{
+			"min":              "El campo {{.label}} debe tener al menos {{.ruleValue}} {{plural .ruleValue \"carácter\" \"caracteres\"}}, el valor informado fue \"{{truncate .value 50}}\".",
+			"min_chars":        "El campo {{.label}} debe tener al menos {{.ruleValue}} {{plural .ruleValue \"carácter\" \"caracteres\"}}, el valor informado fue \"{{truncate .value 50}}\".",
+			"max":              "El campo {{.label}} debe tener como máximo {{.ruleValue}} {{plural .ruleValue \"carácter\" \"caracteres\"}}, el valor informado fue \"{{truncate .value 50}}\".",
+			"max_chars":        "El campo {{.label}} debe tener como máximo {{.ruleValue}} {{plural .ruleValue \"carácter\" \"caracteres\"}}, el valor informado fue \"{{truncate .value 50}}\".",
+			"email":            "El campo {{.label}} no es un correo electrónico válido, el valor informado fue \"{{.value}}\".",
+			"url":              "El campo {{.label}} no es una URL válida, el valor informado fue \"{{.value}}\".",
+			"ipv4":             "El campo {{.label}} no es una IPv4 válida, el valor informado fue \"{{.value}}\".",
+			"json":             "El campo {{.label}} no es un JSON válido, el valor informado fue \"{{.value}}\".",
+			"alpha":            "El campo {{.label}} solo puede contener letras, el valor informado fue \"{{.value}}\".",
+			"alpha_dash":       "El campo {{.label}} solo puede contener letras, números, '-' y '_', el valor informado fue \"{{.value}}\".",
+			"alpha_num":        "El campo {{.label}} solo puede contener letras y números, el valor informado fue \"{{.value}}\".",
+			"alpha_space":      "El campo {{.label}} solo puede contener letras y espacios, el valor informado fue \"{{.value}}\".",
+			"alpha_dash_space": "El campo {{.label}} solo puede contener letras, números, espacios, '-' y '_', el valor informado fue \"{{.value}}\".",
+			"alpha_num_space":  "El campo {{.label}} solo puede contener letras, números y espacios, el valor informado fue \"{{.value}}\".",
+			"length":           "El campo {{.label}} debe tener exactamente {{.ruleValue}} {{plural .ruleValue \"carácter\" \"caracteres\"}}, el valor informado fue \"{{truncate .value 50}}\".",
+			"regex":            "El campo {{.label}} no coincide con el formato {{.ruleValue}}, el valor informado fue \"{{.value}}\".",
+			"immutable":        "El campo {{.label}} no puede ser modificado, el valor anterior era \"{{.previousValue}}\" y el valor informado fue \"{{.value}}\".",
+		},
+		"timestamp": map[string]string{
+			"equal":                "El campo {{.label}} debe ser igual a {{.ruleValue}}, la fecha y hora informada fue {{.value}}.",
+			"after":                "El campo {{.label}} debe ser posterior a {{.ruleValue}}, la fecha y hora informada fue {{.value}}.",
+			"before":               "El campo {{.label}} debe ser anterior a {{.ruleValue}}, la fecha y hora informada fue {{.value}}.",
+			"equal_date":           "El campo {{.label}} debe ser igual a {{.ruleValue}}, la fecha informada fue {{.value}}.",
+			"after_date":           "El campo {{.label}} debe ser posterior a {{.ruleValue}}, la fecha informada fue {{.value}}.",
+			"before_date":          "El campo {{.label}} debe ser anterior a {{.ruleValue}}, la fecha informada fue {{.value}}.",
+			"after_or_equal":       "El campo {{.label}} debe ser posterior o igual a {{.ruleValue}}, la fecha y hora informada fue {{.value}}.",
+			"before_or_equal":      "El campo {{.label}} debe ser anterior o igual a {{.ruleValue}}, la fecha y hora informada fue {{.value}}.",
+			"after_or_equal_date":  "El campo {{.label}} debe ser posterior o igual a {{.ruleValue}}, la fecha informada fue {{.value}}.",
+			"before_or_equal_date": "El campo {{.label}} debe ser anterior o igual a {{.ruleValue}}, la fecha informada fue {{.value}}.",
+			"immutable":            "El campo {{.label}} no puede ser modificado, la fecha y hora anterior era {{.previousValue}} y la fecha y hora informada fue {{.value}}.",
+			"increasing":           "El campo {{.label}} no puede ser anterior a la fecha y hora anterior {{.previousValue}}, la fecha y hora informada fue {{.value}}.",
+		},
+		"schema": map[string]string{
+			"required": "El campo {{.label}} es obligatorio.",
+			"type":     "El campo {{.label}} debe ser del tipo {{.ruleValue}}, el valor informado fue {{.value}}.",
+		},
+		"form": map[string]string{
+			"type": "El campo {{.label}} tiene un valor inválido para el tipo {{typeName .ruleValue}}, el valor informado fue \"{{.value}}\".",
+		},
+	}
+	for rule, message := range requiredMessages {
+		messages["string"][rule] = message
+		messages["array"][rule] = message
+	}
+	AddMessages("es", messages)
+}
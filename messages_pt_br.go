@@ -0,0 +1,73 @@
+package validator
+
+// definition of the pt-BR messages
+func init() {
+	requiredMessages := map[string]string{
+		"required_with":        "O campo {{.label}} é obrigatório quando pelo menos um dos campos ({{.ruleValue}}) está preenchido.",
+		"required_with_all":    "O campo {{.label}} é obrigatório quando todos os campos ({{.ruleValue}}) estão preenchidos.",
+		"required_without":     "O campo {{.label}} é obrigatório quando pelo menos um dos campos ({{.ruleValue}}) não está preenchido.",
+		"required_without_all": "O campo {{.label}} é obrigatório quando nenhum dos campos ({{.ruleValue}}) está preenchido.",
+	}
+	messages := map[string]map[string]string{
+		"numeric": map[string]string{
+			"min":        "O campo {{.label}} não pode ser menor que {{number .ruleValue}}, o valor informado foi {{number .value}}.",
+			"max":        "O campo {{.label}} não pode ser maior que {{number .ruleValue}}, o valor informado foi {{number .value}}.",
+			"immutable":  "O campo {{.label}} não pode ser alterado, o valor anterior era {{number .previousValue}} e o valor informado foi {{number .value}}.",
+			"increasing": "O campo {{.label}} não pode ser menor que o valor anterior {{number .previousValue}}, o valor informado foi {{number .value}}.",
+		},
+		"array": map[string]string{
+			"min":       "O campo {{.label}} deve ter pelo menos {{.ruleValue}} {{plural .ruleValue \"item\" \"itens\"}}, o valor informado foi {{list .value}}.",
+			"max":       "O campo {{.label}} deve ter no máximo {{.ruleValue}} {{plural .ruleValue \"item\" \"itens\"}}, o valor informado foi {{list .value}}.",
+			"distinct":  "O campo {{.label}} não pode ter itens repetidos, o valor informado foi {{list .value}}.",
+			"immutable": "O campo {{.label}} não pode ser alterado, o valor anterior era {{list .previousValue}} e o valor informado foi {{list .value}}.",
+			"no_shrink": "O campo {{.label}} não pode ter menos itens que o valor anterior {{list .previousValue}}, o valor informado foi {{list .value}}.",
+		},
+		"string": map[string]string{
+			"min":              "O campo {{.label}} deve ter pelo menos {{.ruleValue}} {{plural .ruleValue \"caractere\" \"caracteres\"}}, o valor informado foi \"{{truncate .value 50}}\".",
+			"min_chars":        "O campo {{.label}} deve ter pelo menos {{.ruleValue}} {{plural .ruleValue \"caractere\" \"caracteres\"}}, o valor informado foi \"{{truncate .value 50}}\".",
+			"max":              "O campo {{.label}} deve ter no máximo {{.ruleValue}} {{plural .ruleValue \"caractere\" \"caracteres\"}}, o valor informado foi \"{{truncate .value 50}}\".",
+			"max_chars":        "O campo {{.label}} deve ter no máximo {{.ruleValue}} {{plural .ruleValue \"caractere\" \"caracteres\"}}, o valor informado foi \"{{truncate .value 50}}\".",
+			"email":            "O campo {{.label}} não é um e-mail válido, o valor informado foi \"{{.value}}\".",
+			"url":              "O campo {{.label}} não é uma URL válida, o valor informado foi \"{{.value}}\".",
+			"ipv4":             "O campo {{.label}} não é um IPv4 válido, o valor informado foi \"{{.value}}\".",
+			"json":             "O campo {{.label}} não é um JSON válido, o valor informado foi \"{{.value}}\".",
+			"alpha":            "O campo {{.label}} deve conter apenas letras, o valor informado foi \"{{.value}}\".",
+			"alpha_dash":       "O campo {{.label}} deve conter apenas letras, números, '-' e '_', o valor informado foi \"{{.value}}\".",
+			"alpha_num":        "O campo {{.label}} deve conter apenas letras e números, o valor informado foi \"{{.value}}\".",
+			"alpha_space":      "O campo {{.label}} deve conter apenas letras e espaços, o valor informado foi \"{{.value}}\".",
+			"alpha_dash_space": "O campo {{.label}} deve conter apenas letras, números, espaços, '-' e '_', o valor informado foi \"{{.value}}\".",
+			"alpha_num_space":  "O campo {{.label}} deve conter apenas letras, números e espaços, o valor informado foi \"{{.value}}\".",
+			"length":           "O campo {{.label}} deve ter exatamente {{.ruleValue}} {{plural .ruleValue \"caractere\" \"caracteres\"}}, o valor informado foi \"{{truncate .value 50}}\".",
+			"regex":            "O campo {{.label}} não corresponde ao formato {{.ruleValue}}, o valor informado foi \"{{.value}}\".",
+			"immutable":        "O campo {{.label}} não pode ser alterado, o valor anterior era \"{{.previousValue}}\" e o valor informado foi \"{{.value}}\".",
+		},
+		"timestamp": map[string]string{
+			"equal":                "O campo {{.label}} deve ser igual a {{.ruleValue}}, a data e hora informada foi {{.value}}.",
+			"after":                "O campo {{.label}} deve ser posterior a {{.ruleValue}}, a data e hora informada foi {{.value}}.",
+			"before":               "O campo {{.label}} deve ser anterior a {{.ruleValue}}, a data e hora informada foi {{.value}}.",
+			"equal_date":           "O campo {{.label}} deve ser igual a {{.ruleValue}}, a data informada foi {{.value}}.",
+			"after_date":           "O campo {{.label}} deve ser posterior a {{.ruleValue}}, a data informada foi {{.value}}.",
+			"before_date":          "O campo {{.label}} deve ser anterior a {{.ruleValue}}, a data informada foi {{.value}}.",
+			"after_or_equal":       "O campo {{.label}} deve ser posterior ou igual a {{.ruleValue}}, a data e hora informada foi {{.value}}.",
+			"before_or_equal":      "O campo {{.label}} deve ser anterior ou igual a {{.ruleValue}}, a data e hora informada foi {{.value}}.",
+			"after_or_equal_date":  "O campo {{.label}} deve ser posterior ou igual a {{.ruleValue}}, a data informada foi {{.value}}.",
+			"before_or_equal_date": "O campo {{.label}} deve ser anterior ou igual a {{.ruleValue}}, a data informada foi {{.value}}.",
+			"immutable":            "O campo {{.label}} não pode ser alterado, a data e hora anterior era {{.previousValue}} e a data e hora informada foi {{.value}}.",
+			"increasing":           "O campo {{.label}} não pode ser anterior à data e hora anterior {{.previousValue}}, a data e hora informada foi {{.value}}.",
+		},
+		"schema": map[string]string{
+			"required": "O campo {{.label}} é obrigatório.",
+			"type":     "O campo {{.label}} deve ser do tipo {{.ruleValue}}, o valor informado foi {{.value}}.",
+		},
+		"form": map[string]string{
+			"type": "O campo {{.label}} possui um valor inválido para o tipo {{typeName .ruleValue}}, o valor informado foi \"{{.value}}\".",
+		},
+	}
+	for rule, message := range requiredMessages {
+		messages["string"][rule] = message
+		messages["array"][rule] = message
+	}
+	// pt is the fallback of the other variants, like pt-PT
+	AddMessages("pt", messages)
+	AddMessages("pt-BR", messages)
+}
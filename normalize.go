@@ -0,0 +1,153 @@
+package validator
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+)
+
+var (
+	// normalizers - relation between the names used in the "normalize" tag and the functions that
+	// normalize the strings
+	normalizers = map[string]func(string) string{
+		"trim":            strings.TrimSpace,
+		"lower":           strings.ToLower,
+		"upper":           strings.ToUpper,
+		"collapse_spaces": collapseSpaces,
+		"digits":          onlyDigits,
+	}
+	// nativeNormalizers - names of the normalizers that cannot be changed by AddNormalizer
+	nativeNormalizers = make(map[string]bool)
+)
+
+func init() {
+	for name := range normalizers {
+		nativeNormalizers[name] = true
+	}
+}
+
+// AddNormalizer - Add a normalizer used in the "normalize" tag, like AddNormalizer("slug", toSlug) for
+// the tag `normalize:"trim|slug"`. Returns an error when the name is of a native normalizer.
+func AddNormalizer(name string, normalizer func(string) string) error {
+	if name == "" || strings.ContainsAny(name, "|:, ") {
+		return fmt.Errorf("Error: The normalizer %q is not a valid name", name)
+	} else if nativeNormalizers[name] {
+		return fmt.Errorf("Error: The normalizer %s is a native normalizer, you cannot change this normalizer", name)
+	}
+	normalizers[name] = normalizer
+	return nil
+}
+
+// Sanitize - Normalize the strings of the struct pointed by st with the normalizers of their "normalize"
+// tag, applied from left to right, like `normalize:"trim|lower"`. The strings of pointers, lists and
+// nested structs are normalized too, the structs of a cyclic list only once. A panic is throwed if a
+// normalizer does not exist.
+func Sanitize(st interface{}) error {
+	stValue := reflect.ValueOf(st)
+	for stValue.Kind() == reflect.Ptr && !stValue.IsNil() && stValue.Elem().Kind() == reflect.Ptr {
+		stValue = stValue.Elem()
+	}
+	if st == nil || stValue.Kind() != reflect.Ptr || stValue.IsNil() || stValue.Elem().Kind() != reflect.Struct {
+		return errors.New("Error: The interface passed have to be a pointer to a struct")
+	}
+	visited := map[visitedPointer]bool{{stValue.Pointer(), stValue.Type()}: true}
+	sanitizeStruct(stValue.Elem(), visited)
+	return nil
+}
+
+// ValidateAndNormalize - Normalize the struct pointed by st like Sanitize, and validate it like Validate,
+// so the rules validate the normalized values
+func ValidateAndNormalize(st interface{}, messages map[string]map[string]string) (returnedErrors []error) {
+	return validate(st, options{messages: messages, normalize: true}).Errors()
+}
+
+// ValidateAndNormalize - same as the ValidateAndNormalize function, using the configuration of the
+// validator
+func (validator *Validator) ValidateAndNormalize(st interface{}) (returnedErrors []error) {
+	opts := validator.options()
+	opts.normalize = true
+	return validate(st, opts).Errors()
+}
+
+// sanitizeStruct - normalize the exported fields of the struct and of its nested structs, visited has the
+// pointers to the structs that are being normalized
+func sanitizeStruct(stValue reflect.Value, visited map[visitedPointer]bool) {
+	for i := 0; i < stValue.NumField(); i++ {
+		structField := stValue.Type().Field(i)
+		if structField.PkgPath != "" {
+			continue
+		}
+		if tag := strings.TrimSpace(structField.Tag.Get("normalize")); tag != "" {
+			sanitizeValue(stValue.Field(i), mustParseTag(tag))
+		}
+		if isNestedStruct(structField.Type) {
+			sanitizeNested(stValue.Field(i), visited)
+		}
+	}
+}
+
+// sanitizeNested - normalize the fields of a nested struct, or of the structs of a list, the structs
+// pointed by a visited pointer are not normalized again
+func sanitizeNested(value reflect.Value, visited map[visitedPointer]bool) {
+	if value.Kind() == reflect.Ptr && !value.IsNil() {
+		pointer := visitedPointer{value.Pointer(), value.Type()}
+		if visited[pointer] {
+			return
+		}
+		visited[pointer] = true
+		defer delete(visited, pointer)
+	}
+	value = reflect.Indirect(value)
+	switch value.Kind() {
+	case reflect.Struct:
+		sanitizeStruct(value, visited)
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			sanitizeNested(value.Index(i), visited)
+		}
+	}
+}
+
+// sanitizeValue - normalize the string, or the strings pointed and the strings of lists, with the rules
+func sanitizeValue(value reflect.Value, rules []TagRule) {
+	switch value.Kind() {
+	case reflect.Ptr:
+		if !value.IsNil() {
+			sanitizeValue(value.Elem(), rules)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			sanitizeValue(value.Index(i), rules)
+		}
+	case reflect.String:
+		normalizedValue := value.String()
+		for _, rule := range rules {
+			normalizer, ok := normalizers[rule.Name]
+			if !ok {
+				panic(fmt.Sprintf("The normalizer '%s' does not exists", rule.Name))
+			}
+			normalizedValue = normalizer(normalizedValue)
+		}
+		if value.CanSet() {
+			value.SetString(normalizedValue)
+		}
+	}
+}
+
+// collapseSpaces - replace the sequences of spaces by one space and remove the spaces at the start and at
+// the end
+func collapseSpaces(value string) string {
+	return strings.Join(strings.Fields(value), " ")
+}
+
+// onlyDigits - remove the characters that are not digits, like the punctuation of phone numbers
+func onlyDigits(value string) string {
+	return strings.Map(func(char rune) rune {
+		if unicode.IsDigit(char) {
+			return char
+		}
+		return -1
+	}, value)
+}
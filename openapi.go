@@ -0,0 +1,295 @@
+package validator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// OpenAPISchema - Schema object of the OpenAPI 3 specification, the Extensions are written as "x-" keys
+type OpenAPISchema struct {
+	Ref         string                    `json:"$ref,omitempty"`
+	Type        string                    `json:"type,omitempty"`
+	Format      string                    `json:"format,omitempty"`
+	Pattern     string                    `json:"pattern,omitempty"`
+	MinLength   *uint64                   `json:"minLength,omitempty"`
+	MaxLength   *uint64                   `json:"maxLength,omitempty"`
+	Minimum     *float64                  `json:"minimum,omitempty"`
+	Maximum     *float64                  `json:"maximum,omitempty"`
+	MinItems    *uint64                   `json:"minItems,omitempty"`
+	MaxItems    *uint64                   `json:"maxItems,omitempty"`
+	UniqueItems bool                      `json:"uniqueItems,omitempty"`
+	Items       *OpenAPISchema            `json:"items,omitempty"`
+	Properties  map[string]*OpenAPISchema `json:"properties,omitempty"`
+	Required    []string                  `json:"required,omitempty"`
+	AllOf       []*OpenAPISchema          `json:"allOf,omitempty"`
+	Extensions  map[string]interface{}    `json:"-"`
+}
+
+// OpenAPIComponents - Components object of the OpenAPI 3 specification, only with the schemas
+type OpenAPIComponents struct {
+	Schemas map[string]*OpenAPISchema `json:"schemas"`
+	// types - the struct types of the schemas, by the name of the schema
+	types map[string]reflect.Type
+}
+
+// MarshalJSON - Write the schema with its extensions
+func (schema OpenAPISchema) MarshalJSON() ([]byte, error) {
+	type openAPISchema OpenAPISchema
+	schemaJSON, err := json.Marshal(openAPISchema(schema))
+	if err != nil || len(schema.Extensions) == 0 {
+		return schemaJSON, err
+	}
+	var schemaMap map[string]interface{}
+	if err := json.Unmarshal(schemaJSON, &schemaMap); err != nil {
+		return nil, err
+	}
+	for name, value := range schema.Extensions {
+		schemaMap[name] = value
+	}
+	return json.Marshal(schemaMap)
+}
+
+// GenerateOpenAPIComponents - Generate the OpenAPI components.schemas of the models, the constraints are
+// derived from the validator tag and the property names from the "json" tag. The structs used by the
+// fields of the models are added to the schemas too.
+func GenerateOpenAPIComponents(models ...interface{}) (*OpenAPIComponents, error) {
+	components := &OpenAPIComponents{Schemas: make(map[string]*OpenAPISchema), types: make(map[string]reflect.Type)}
+	for _, model := range models {
+		if model == nil {
+			return nil, errors.New("The interface passed is nil")
+		}
+		modelType := reflect.TypeOf(model)
+		for modelType.Kind() == reflect.Ptr {
+			modelType = modelType.Elem()
+		}
+		if modelType.Kind() != reflect.Struct {
+			return nil, fmt.Errorf("Error: The model %s is not a struct", modelType)
+		}
+		if _, err := components.addStruct(modelType); err != nil {
+			return nil, err
+		}
+	}
+	return components, nil
+}
+
+// addStruct - add the schema of a struct type to the components, if it was not added yet, and returns
+// the name of the schema. The name is the name of the type, the types with the name of a type of another
+// package have the name of their package too, like "shipping.Address".
+func (components *OpenAPIComponents) addStruct(structType reflect.Type) (string, error) {
+	name := structType.Name()
+	if schemaType, ok := components.types[name]; ok && schemaType != structType {
+		name = path.Base(structType.PkgPath()) + "." + name
+		if otherType, ok := components.types[name]; (ok && otherType != structType) || schemaType.PkgPath() == structType.PkgPath() {
+			return "", fmt.Errorf("Error: The type %s of %s has the schema name of another type", structType, structType.PkgPath())
+		}
+	}
+	if components.types[name] != nil {
+		return name, nil
+	}
+	schema := &OpenAPISchema{Type: "object", Properties: make(map[string]*OpenAPISchema)}
+	components.Schemas[name], components.types[name] = schema, structType
+	return name, components.addProperties(schema, structType)
+}
+
+// addProperties - add the exported fields of a struct type as properties of the schema
+func (components *OpenAPIComponents) addProperties(schema *OpenAPISchema, structType reflect.Type) error {
+	// relation between field names and property names, used by the required_* rules
+	propertyNames := make(map[string]string)
+	for i := 0; i < structType.NumField(); i++ {
+		propertyNames[structType.Field(i).Name] = getJSONName(structType.Field(i))
+	}
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		propertyName := propertyNames[field.Name]
+		if field.PkgPath != "" || propertyName == "-" {
+			continue
+		}
+		if field.Anonymous && field.Type.Kind() == reflect.Struct && field.Tag.Get("json") == "" {
+			// embedded structs have their fields promoted, like in encoding/json
+			if err := components.addProperties(schema, field.Type); err != nil {
+				return err
+			}
+			continue
+		}
+		property, err := components.getTypeSchema(field.Type)
+		if err != nil {
+			return err
+		}
+		required, err := applyOpenAPIRules(property, GetValidatorKeyType(field.Type.String()), field.Tag.Get(TagName), propertyNames)
+		if err != nil {
+			return fmt.Errorf("Error: The field %s.%s has an invalid tag: %v", structType.Name(), field.Name, err)
+		}
+		if required {
+			schema.Required = append(schema.Required, propertyName)
+		}
+		schema.Properties[propertyName] = property
+	}
+	return nil
+}
+
+// getTypeSchema - returns the schema of a golang type, without constraints
+func (components *OpenAPIComponents) getTypeSchema(fieldType reflect.Type) (*OpenAPISchema, error) {
+	for fieldType.Kind() == reflect.Ptr {
+		fieldType = fieldType.Elem()
+	}
+	if fieldType == reflect.TypeOf(time.Time{}) {
+		return &OpenAPISchema{Type: "string", Format: "date-time"}, nil
+	}
+	switch fieldType.Kind() {
+	case reflect.String:
+		return &OpenAPISchema{Type: "string"}, nil
+	case reflect.Bool:
+		return &OpenAPISchema{Type: "boolean"}, nil
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16:
+		return &OpenAPISchema{Type: "integer", Format: "int32"}, nil
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		// int and uint have 64 bits and uint32 exceeds the int32 range
+		return &OpenAPISchema{Type: "integer", Format: "int64"}, nil
+	case reflect.Float32:
+		return &OpenAPISchema{Type: "number", Format: "float"}, nil
+	case reflect.Float64:
+		return &OpenAPISchema{Type: "number", Format: "double"}, nil
+	case reflect.Slice, reflect.Array:
+		items, err := components.getTypeSchema(fieldType.Elem())
+		return &OpenAPISchema{Type: "array", Items: items}, err
+	case reflect.Map:
+		return &OpenAPISchema{Type: "object"}, nil
+	case reflect.Struct:
+		if fieldType.Name() == "" {
+			schema := &OpenAPISchema{Type: "object", Properties: make(map[string]*OpenAPISchema)}
+			return schema, components.addProperties(schema, fieldType)
+		}
+		name, err := components.addStruct(fieldType)
+		return &OpenAPISchema{Ref: "#/components/schemas/" + name}, err
+	}
+	// interfaces accept any value
+	return &OpenAPISchema{}, nil
+}
+
+// applyOpenAPIRules - add the constraints of the rules in the tag to the schema, returns true if the
+// property is required
+func applyOpenAPIRules(schema *OpenAPISchema, validatorKeyType string, tags string, propertyNames map[string]string) (required bool, err error) {
+	if validatorKeyType == "" || len(strings.TrimSpace(tags)) == 0 {
+		return false, nil
+	}
+	for _, rule := range getTagRules(tags) {
+		if modifiers[rule.Name] {
+			continue
+		}
+		switch validatorKeyType + "." + rule.Name {
+		case "string.min", "string.max", "string.length":
+			// the lengths of OpenAPI are counted in characters and these rules count bytes
+			uintRuleValue, err := GetUintFromString(rule.Value)
+			if err != nil {
+				return false, err
+			}
+			if schema.Extensions == nil {
+				schema.Extensions = make(map[string]interface{})
+			}
+			schema.Extensions["x-"+rule.Name+"-bytes"] = uintRuleValue
+		case "string.min_chars", "string.max_chars", "array.min", "array.max":
+			uintRuleValue, err := GetUintFromString(rule.Value)
+			if err != nil {
+				return false, err
+			}
+			if rule.Name == "min_chars" {
+				schema.MinLength = &uintRuleValue
+			}
+			if rule.Name == "max_chars" {
+				schema.MaxLength = &uintRuleValue
+			}
+			if rule.Name == "min" && validatorKeyType == "array" {
+				schema.MinItems = &uintRuleValue
+			}
+			if rule.Name == "max" && validatorKeyType == "array" {
+				schema.MaxItems = &uintRuleValue
+			}
+		case "numeric.min", "numeric.max":
+			floatRuleValue, err := GetFloatFromString(rule.Value)
+			if err != nil {
+				return false, err
+			}
+			if rule.Name == "min" {
+				schema.Minimum = &floatRuleValue
+			} else {
+				schema.Maximum = &floatRuleValue
+			}
+		case "string.email":
+			schema.Format = "email"
+		case "string.url":
+			schema.Format = "uri"
+		case "string.ipv4":
+			schema.Format = "ipv4"
+		case "string.regex":
+			schema.addPattern(rule.Value)
+		case "string.alpha", "string.alpha_dash", "string.alpha_num", "string.alpha_space", "string.alpha_dash_space", "string.alpha_num_space":
+			schema.addPattern(openAPIPatterns[rule.Name])
+		case "string.required", "array.required":
+			one := uint64(1)
+			if validatorKeyType == "string" && schema.MinLength == nil {
+				schema.MinLength = &one
+			} else if validatorKeyType == "array" && schema.MinItems == nil {
+				schema.MinItems = &one
+			}
+			required = true
+		case "array.distinct":
+			schema.UniqueItems = true
+		default:
+			// rules without a native keyword, like required_with, are written as extensions
+			if schema.Extensions == nil {
+				schema.Extensions = make(map[string]interface{})
+			}
+			extensionName := "x-" + strings.Replace(rule.Name, "_", "-", -1)
+			if rule.Value == "" {
+				schema.Extensions[extensionName] = true
+			} else if strings.HasPrefix(rule.Name, "required_with") {
+				fieldsNames := GetFieldsNamesFromRuleString(rule.Value)
+				for i, fieldName := range fieldsNames {
+					if propertyName, ok := propertyNames[fieldName]; ok {
+						fieldsNames[i] = propertyName
+					}
+				}
+				schema.Extensions[extensionName] = fieldsNames
+			} else {
+				schema.Extensions[extensionName] = rule.Value
+			}
+		}
+	}
+	return required, nil
+}
+
+// addPattern - set the pattern of the schema, the patterns of the next rules are added to allOf, so the
+// value has to match all of them
+func (schema *OpenAPISchema) addPattern(pattern string) {
+	if schema.Pattern == "" {
+		schema.Pattern = pattern
+	} else if schema.Pattern != pattern {
+		schema.AllOf = append(schema.AllOf, &OpenAPISchema{Pattern: pattern})
+	}
+}
+
+var (
+	// relation between the alpha rules and their regular expressions
+	openAPIPatterns = map[string]string{
+		"alpha":            AlphabeticRegex,
+		"alpha_dash":       AlphaNumericDashRegex,
+		"alpha_num":        AlphaNumericRegex,
+		"alpha_space":      AlphabeticSpacesRegex,
+		"alpha_dash_space": AlphaNumericDashSpacesRegex,
+		"alpha_num_space":  AlphaNumericSpacesRegex,
+	}
+)
+
+// getJSONName - returns the name of the field in the "json" tag without the options, or the field name
+// when the tag has no name
+func getJSONName(field reflect.StructField) string {
+	if name := strings.Split(field.Tag.Get("json"), ",")[0]; name != "" {
+		return name
+	}
+	return field.Name
+}
@@ -0,0 +1,92 @@
+package validator
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestGenerateOpenAPIComponents(t *testing.T) {
+	type Address struct {
+		Zip  string `json:"zip" struct-validator:"length:8|regex:^[0-9]*$"`
+		City string `json:"city" struct-validator:"min_chars:2|max_chars:40"`
+	}
+	type Customer struct {
+		ID       int64     `json:"id,omitempty" struct-validator:"min:1|max:20"`
+		Name     string    `json:"name" struct-validator:"alpha_space|required"`
+		Code     string    `json:"code" struct-validator:"alpha|regex:^a"`
+		Email    string    `json:"email" struct-validator:"email|required_with:Phone"`
+		Phone    string    `json:"phone"`
+		Age      int       `json:"age"`
+		Level    uint32    `json:"level"`
+		Rank     int16     `json:"rank"`
+		Tags     []string  `json:"tags" struct-validator:"distinct|max:3"`
+		CreateAt time.Time `json:"createAt" struct-validator:"after_or_equal_date:today"`
+		Address  *Address  `json:"address"`
+		internal string
+	}
+	t.Log("\nIt tests the schemas generated from the tags\n")
+	components, err := GenerateOpenAPIComponents(Customer{})
+	if err != nil {
+		t.Fatalf("\nReceived: %v.\nShould be: nil.\n", err)
+	}
+	componentsJSON, _ := json.Marshal(components)
+	patternJSON, _ := json.Marshal(AlphabeticSpacesRegex)
+	alphaJSON, _ := json.Marshal(AlphabeticRegex)
+	expected := `{"schemas":{"Address":{"type":"object","properties":{"zip":{"type":"string","pattern":"^[0-9]*$","x-length-bytes":8},"city":{"type":"string","minLength":2,"maxLength":40}}},` +
+		`"Customer":{"type":"object","properties":{` +
+		`"address":{"$ref":"#/components/schemas/Address"},` +
+		`"age":{"type":"integer","format":"int64"},` +
+		`"code":{"type":"string","pattern":` + string(alphaJSON) + `,"allOf":[{"pattern":"^a"}]},` +
+		`"createAt":{"type":"string","format":"date-time","x-after-or-equal-date":"today"},` +
+		`"email":{"type":"string","format":"email","x-required-with":["phone"]},` +
+		`"id":{"type":"integer","format":"int64","minimum":1,"maximum":20},` +
+		`"level":{"type":"integer","format":"int64"},` +
+		`"name":{"type":"string","pattern":` + string(patternJSON) + `,"minLength":1},` +
+		`"phone":{"type":"string"},` +
+		`"rank":{"type":"integer","format":"int32"},` +
+		`"tags":{"type":"array","maxItems":3,"uniqueItems":true,"items":{"type":"string"}}},` +
+		`"required":["name"]}}}`
+	var expectedValue, receivedValue interface{}
+	json.Unmarshal([]byte(expected), &expectedValue)
+	json.Unmarshal(componentsJSON, &receivedValue)
+	expectedJSON, _ := json.Marshal(expectedValue)
+	receivedJSON, _ := json.Marshal(receivedValue)
+	if string(expectedJSON) != string(receivedJSON) {
+		t.Errorf("\nReceived: %s.\nShould be: %s.\n", receivedJSON, expectedJSON)
+	}
+}
+
+// Location - struct with the name of time.Location
+type Location struct {
+	City string `json:"city" struct-validator:"required"`
+}
+
+func TestGenerateOpenAPIComponentsNames(t *testing.T) {
+	type Trip struct {
+		From Location       `json:"from"`
+		Zone *time.Location `json:"zone"`
+	}
+	t.Log("\nIt tests the schemas of types with the same name in different packages\n")
+	components, err := GenerateOpenAPIComponents(Trip{})
+	if err != nil {
+		t.Fatalf("\nReceived: %v.\nShould be: nil.\n", err)
+	}
+	trip := components.Schemas["Trip"]
+	if trip.Properties["from"].Ref != "#/components/schemas/Location" || trip.Properties["zone"].Ref != "#/components/schemas/time.Location" ||
+		components.Schemas["Location"].Required == nil || components.Schemas["time.Location"] == nil {
+		t.Errorf("\nReceived: %s %s.\nShould be: #/components/schemas/Location #/components/schemas/time.Location.\n", trip.Properties["from"].Ref, trip.Properties["zone"].Ref)
+	}
+	type Location struct {
+		Zip string `json:"zip"`
+	}
+	type Shipping struct {
+		To Location `json:"to"`
+	}
+	t.Log("\nIt tests the error of types with the same name in the same package\n")
+	expected := "Error: The type validator.Location of " + reflect.TypeOf(Location{}).PkgPath() + " has the schema name of another type"
+	if _, err := GenerateOpenAPIComponents(Trip{}, Shipping{}); err == nil || err.Error() != expected {
+		t.Errorf("\nReceived: %v.\nShould be: %s.\n", err, expected)
+	}
+}
@@ -0,0 +1,160 @@
+package validator
+
+import (
+	"strconv"
+	"strings"
+)
+
+// fieldsSelection - selection of the fields of a struct by paths split in segments, like
+// ["items", "[*]", "sku"] for "items[*].sku". A nil include selects all fields, and the fields of the
+// exclude paths are not validated. The nested structs are validated when all is true, like the nested
+// structs of a struct selected by its path, or when the paths have their fields. The rules paths select
+// only the rules of the fields, not their nested fields, like the lists with changed items of
+// ValidateUpdate.
+type fieldsSelection struct {
+	include [][]string
+	exclude [][]string
+	rules   [][]string
+	all     bool
+}
+
+// splitFieldPath - returns the segments of a path, like "items[*].sku" or "address.zip", the indexes
+// are segments like "[*]" or "[2]"
+func splitFieldPath(path string) (segments []string) {
+	for _, part := range strings.Split(path, ".") {
+		for index := strings.Index(part, "["); index >= 0; index = strings.Index(part, "[") {
+			if index > 0 {
+				segments = append(segments, part[:index])
+			}
+			end := strings.Index(part, "]")
+			if end < index {
+				end = len(part) - 1
+			}
+			segments = append(segments, part[index:end+1])
+			part = part[end+1:]
+		}
+		if part != "" {
+			segments = append(segments, part)
+		}
+	}
+	return segments
+}
+
+// getFieldsPaths - returns the segments of the paths, the empty paths are ignored
+func getFieldsPaths(paths []string) [][]string {
+	fieldsPaths := make([][]string, 0, len(paths))
+	for _, path := range paths {
+		if segments := splitFieldPath(strings.TrimSpace(path)); len(segments) > 0 {
+			fieldsPaths = append(fieldsPaths, segments)
+		}
+	}
+	return fieldsPaths
+}
+
+// selectField - returns the selection of the nested fields of a field, matched by the names of the
+// field, true if the rules of the field are validated, and false in ok if the field and its nested
+// fields are not selected
+func (selection fieldsSelection) selectField(names ...string) (nested fieldsSelection, rules bool, ok bool) {
+	nested.all = selection.all
+	if selection.include != nil {
+		nested.include = make([][]string, 0)
+		for _, segments := range selection.include {
+			if !matchFieldSegment(segments[0], names) {
+				continue
+			} else if len(segments) == 1 {
+				// the field and all its nested fields
+				nested.include, nested.all, rules = nil, true, true
+				break
+			}
+			nested.include = append(nested.include, segments[1:])
+		}
+		for _, segments := range selection.rules {
+			if !matchFieldSegment(segments[0], names) {
+				continue
+			} else if len(segments) == 1 {
+				rules = true
+			} else {
+				nested.rules = append(nested.rules, segments[1:])
+			}
+		}
+		if nested.include != nil && len(nested.include) == 0 && !rules && len(nested.rules) == 0 {
+			return nested, false, false
+		}
+	} else {
+		rules = true
+	}
+	for _, segments := range selection.exclude {
+		if !matchFieldSegment(segments[0], names) {
+			continue
+		} else if len(segments) == 1 {
+			return nested, false, false
+		}
+		nested.exclude = append(nested.exclude, segments[1:])
+	}
+	return nested, rules, true
+}
+
+// selectIndex - returns the selection of the fields of the item of a list, paths without index, like
+// "items.sku", select all items. The ok is false if the item is not selected.
+func (selection fieldsSelection) selectIndex(index int) (nested fieldsSelection, ok bool) {
+	nested.all = selection.all
+	if selection.include != nil {
+		nested.include = make([][]string, 0)
+		for _, segments := range selection.include {
+			if !isIndexSegment(segments[0]) {
+				nested.include = append(nested.include, segments)
+			} else if matchIndexSegment(segments[0], index) && len(segments) == 1 {
+				nested.include, nested.all = nil, true
+				break
+			} else if matchIndexSegment(segments[0], index) {
+				nested.include = append(nested.include, segments[1:])
+			}
+		}
+		for _, segments := range selection.rules {
+			if !isIndexSegment(segments[0]) {
+				nested.rules = append(nested.rules, segments)
+			} else if matchIndexSegment(segments[0], index) && len(segments) > 1 {
+				nested.rules = append(nested.rules, segments[1:])
+			}
+		}
+		if nested.include != nil && len(nested.include) == 0 && len(nested.rules) == 0 {
+			return nested, false
+		}
+	}
+	for _, segments := range selection.exclude {
+		if !isIndexSegment(segments[0]) {
+			nested.exclude = append(nested.exclude, segments)
+		} else if matchIndexSegment(segments[0], index) && len(segments) == 1 {
+			return nested, false
+		} else if matchIndexSegment(segments[0], index) {
+			nested.exclude = append(nested.exclude, segments[1:])
+		}
+	}
+	return nested, true
+}
+
+// selectsNested - check if the nested structs of the field are validated, by all or by the paths of
+// their fields
+func (selection fieldsSelection) selectsNested() bool {
+	return selection.all || len(selection.include) > 0 || len(selection.exclude) > 0 || len(selection.rules) > 0
+}
+
+// matchFieldSegment - check if the segment is one of the names, ignoring the case
+func matchFieldSegment(segment string, names []string) bool {
+	for _, name := range names {
+		if strings.EqualFold(segment, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// isIndexSegment - check if the segment is an index, like "[*]" or "[2]"
+func isIndexSegment(segment string) bool {
+	return strings.HasPrefix(segment, "[")
+}
+
+// matchIndexSegment - check if the index segment is "[*]" or the index
+func matchIndexSegment(segment string, index int) bool {
+	return segment == "[*]" || segment == "["+strconv.Itoa(index)+"]"
+}
@@ -0,0 +1,120 @@
+package validator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ValidateJSON - Decode the JSON document into the struct pointed by st and validate it. The keys of the
+// document are the present keys used by the "sometimes" modifier, so the fields with "sometimes" are
+// validated only when their path, like "address.zip", is in the document. The error is returned when
+// the document cannot be decoded.
+func ValidateJSON(data []byte, st interface{}, messages map[string]map[string]string) (ValidationErrors, error) {
+	return validateJSON(data, st, options{messages: messages})
+}
+
+// ValidateJSON - same as the ValidateJSON function, using the configuration of the validator
+func (validator *Validator) ValidateJSON(data []byte, st interface{}) (ValidationErrors, error) {
+	return validateJSON(data, st, validator.options())
+}
+
+// validateJSON - decode the document into st and validate it with the keys of the document as present,
+// the keys are read by a scan of the tokens, without decoding the document again
+func validateJSON(data []byte, st interface{}, opts options) (ValidationErrors, error) {
+	if err := json.NewDecoder(bytes.NewReader(data)).Decode(st); err != nil {
+		return nil, err
+	}
+	opts.present = make(map[string]bool)
+	if err := addJSONKeys(json.NewDecoder(bytes.NewReader(data)), "", opts.present); err != nil {
+		return nil, err
+	}
+	return validate(st, opts), nil
+}
+
+// addJSONKeys - add the paths of the keys of the next JSON value of the decoder to present, in lower
+// case, like "address.zip" and "items[0].sku"
+func addJSONKeys(decoder *json.Decoder, path string, present map[string]bool) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	switch token {
+	case json.Delim('{'):
+		for decoder.More() {
+			key, err := decoder.Token()
+			if err != nil {
+				return err
+			}
+			keyPath := key.(string)
+			if path != "" {
+				keyPath = path + "." + keyPath
+			}
+			present[strings.ToLower(keyPath)] = true
+			if err := addJSONKeys(decoder, keyPath, present); err != nil {
+				return err
+			}
+		}
+	case json.Delim('['):
+		for i := 0; decoder.More(); i++ {
+			itemPath := fmt.Sprintf("%s[%d]", path, i)
+			present[strings.ToLower(itemPath)] = true
+			if err := addJSONKeys(decoder, itemPath, present); err != nil {
+				return err
+			}
+		}
+	default:
+		return nil
+	}
+	// the closing delimiter of the object or array
+	_, err = decoder.Token()
+	return err
+}
+
+// skipValidations - check if the rules of the field are not validated because of the modifiers of the
+// tag: omitempty skips the empty values and the nil values, nullable skips the nil values and sometimes
+// skips the fields without a present key, when the present keys are known
+func skipValidations(tags string, field reflect.Value, messageInput MessageInput, present map[string]bool) bool {
+	for _, rule := range getTagRules(tags) {
+		switch {
+		case rule.Name == "omitempty" && (isNilValue(field) || isEmptyValue(messageInput.FieldValue)):
+			return true
+		case rule.Name == "nullable" && isNilValue(field):
+			return true
+		case rule.Name == "sometimes" && present != nil && !present[strings.ToLower(messageInput.Path)]:
+			return true
+		}
+	}
+	return false
+}
+
+// isNilValue - check if the field is a nil pointer, slice, map or interface, like a JSON null, or a
+// driver.Valuer with a null value, like an invalid sql.NullString
+func isNilValue(field reflect.Value) bool {
+	return isNilReference(field) || isNullValuer(field)
+}
+
+// isNilReference - check if the field is a nil pointer, slice, map or interface
+func isNilReference(field reflect.Value) bool {
+	switch field.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Map, reflect.Interface:
+		return field.IsNil()
+	}
+	return false
+}
+
+// isEmptyValue - check if the value used by the rules is empty: an empty string or list, zero or the
+// zero time
+func isEmptyValue(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+	reflectValue := reflect.ValueOf(value)
+	switch reflectValue.Kind() {
+	case reflect.String, reflect.Slice, reflect.Map, reflect.Array:
+		return reflectValue.Len() == 0
+	}
+	return reflectValue.IsZero()
+}
@@ -0,0 +1,60 @@
+package validator
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"unicode/utf8"
+)
+
+var (
+	// RedactValue - Returns the masked value of a sensitive field, used in the messages instead of the value
+	RedactValue = func(value interface{}) string {
+		valueString := fmt.Sprint(value)
+		if length := utf8.RuneCountInString(valueString); length >= 12 {
+			// like card numbers, only the last 4 characters are shown
+			return "****" + string([]rune(valueString)[length-4:])
+		}
+		return "****"
+	}
+	// sensitiveFieldPatterns - patterns of the names of sensitive fields
+	sensitiveFieldPatterns = make([]*regexp.Regexp, 0)
+)
+
+// redactedValue - masked value of a sensitive field, the functions of the templates, like number and list,
+// return it unchanged
+type redactedValue string
+
+// AddSensitiveFieldPattern - Add a regular expression of the names of sensitive fields, like
+// "(?i)password|card_?number", it is matched against the field name and the name in the "json" tag. The
+// values of sensitive fields are masked with RedactValue in the messages.
+func AddSensitiveFieldPattern(pattern string) error {
+	compiledPattern, err := regexp.Compile(pattern)
+	if err != nil {
+		return err
+	}
+	sensitiveFieldPatterns = append(sensitiveFieldPatterns, compiledPattern)
+	clearCaches()
+	return nil
+}
+
+// isSensitiveField - check if the field has the "sensitive" or "redact" modifier in the tag or if its
+// name matches some sensitive field pattern
+func isSensitiveField(structField reflect.StructField) bool {
+	for _, rule := range getTagRules(structField.Tag.Get(TagName)) {
+		if rule.Name == "sensitive" || rule.Name == "redact" {
+			return true
+		}
+	}
+	return isSensitiveName(structField.Name) || isSensitiveName(getJSONName(structField))
+}
+
+// isSensitiveName - check if the name matches some sensitive field pattern
+func isSensitiveName(name string) bool {
+	for _, pattern := range sensitiveFieldPatterns {
+		if pattern.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
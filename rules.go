@@ -0,0 +1,120 @@
+package validator
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// rulesFileField - field of a rules file, the rules use the syntax of the tags
+type rulesFileField struct {
+	Type     string                     `json:"type" yaml:"type"`
+	Rules    string                     `json:"rules" yaml:"rules"`
+	Required bool                       `json:"required" yaml:"required"`
+	Fields   map[string]*rulesFileField `json:"fields" yaml:"fields"`
+	Items    *rulesFileField            `json:"items" yaml:"items"`
+}
+
+// LoadRules - Read a rules file and returns the RuleSet that represents it. The file is an object with
+// the fields, each field has the JSON type, the rules with the syntax of the tags, if it is required,
+// the fields of an object and the items of an array:
+//
+//	name: {type: string, rules: "required|min:3", required: true}
+//	tags: {type: array, rules: "distinct", items: {type: string, rules: "alpha"}}
+//
+// The format is json or yaml, and an error is returned if some rule cannot be used in its field, or if
+// the rules have a modifier, like omitempty.
+func LoadRules(r io.Reader, format string) (*RuleSet, error) {
+	fields := make(map[string]*rulesFileField)
+	switch format {
+	case "json":
+		decoder := json.NewDecoder(r)
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&fields); err != nil {
+			return nil, fmt.Errorf("Error: The rules are not valid: %v", err)
+		}
+	case "yaml", "yml":
+		decoder := yaml.NewDecoder(r)
+		decoder.KnownFields(true)
+		if err := decoder.Decode(&fields); err != nil && err != io.EOF {
+			return nil, fmt.Errorf("Error: The rules are not valid: %v", err)
+		}
+	default:
+		return nil, fmt.Errorf("Error: The format %s is not supported, use json or yaml", format)
+	}
+	ruleSetFields, err := getRulesFileFields("", fields)
+	if err != nil {
+		return nil, err
+	}
+	return &RuleSet{Fields: ruleSetFields}, nil
+}
+
+// LoadRulesFile - Read a rules file, the format is chosen by the file extension (.json, .yaml or .yml)
+func LoadRulesFile(path string) (*RuleSet, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return LoadRules(file, strings.TrimPrefix(filepath.Ext(path), "."))
+}
+
+// getRulesFileFields - convert the fields of a rules file to FieldRules, sorted by name
+func getRulesFileFields(path string, fields map[string]*rulesFileField) ([]*FieldRules, error) {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	fieldsRules := make([]*FieldRules, 0, len(names))
+	for _, name := range names {
+		fieldRules, err := getRulesFileField(path+name, fields[name], names)
+		if err != nil {
+			return nil, err
+		}
+		fieldRules.Name = name
+		fieldsRules = append(fieldsRules, fieldRules)
+	}
+	return fieldsRules, nil
+}
+
+// getRulesFileField - convert a field of a rules file to FieldRules, the rules are checked like the
+// tags by CheckTag and the modifiers return an error
+func getRulesFileField(path string, field *rulesFileField, fieldsNames []string) (*FieldRules, error) {
+	if field == nil {
+		return &FieldRules{}, nil
+	}
+	validatorKeyType, ok := jsonSchemaValidatorKeyTypes[field.Type]
+	if !ok && field.Type != "" {
+		return nil, fmt.Errorf("Error: The field %s has the type %s, use string, number, integer, boolean, array or object", path, field.Type)
+	}
+	fieldRules := &FieldRules{ValidatorKeyType: validatorKeyType, Type: field.Type, Required: field.Required}
+	rules, err := ParseTag(field.Rules)
+	if err != nil {
+		return nil, fmt.Errorf("Error: The field %s has invalid rules: %v", path, err)
+	}
+	for _, rule := range expandAliases(rules, "", make(map[string]bool)) {
+		if modifiers[rule.Name] {
+			// the RuleSet has no modifiers, the required key of the field is used instead of them
+			return nil, fmt.Errorf("Error: The field %s has the modifier %s, the modifiers cannot be used in rules files", path, rule.Name)
+		} else if message := checkRule(validatorKeyType, field.Type, rule, fieldsNames); message != "" {
+			return nil, fmt.Errorf("Error: The field %s has invalid rules: %s", path, message)
+		}
+		fieldRules.Rules = append(fieldRules.Rules, Rule{Name: rule.Name, Value: rule.Value})
+	}
+	if fieldRules.Fields, err = getRulesFileFields(path+".", field.Fields); err != nil {
+		return nil, err
+	}
+	if field.Items != nil {
+		if fieldRules.Items, err = getRulesFileField(path+"[]", field.Items, nil); err != nil {
+			return nil, err
+		}
+	}
+	return fieldRules, nil
+}
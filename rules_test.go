@@ -0,0 +1,67 @@
+package validator
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var (
+	rulesTest = `
+name: {type: string, rules: "min:3|max:20", required: true}
+age: {type: integer, rules: "min:18"}
+tags:
+  type: array
+  rules: distinct
+  items: {type: string, rules: "regex:'^[a-z]*$'"}
+address:
+  type: object
+  fields:
+    zip: {type: string, rules: "length:8", required: true}
+`
+)
+
+func TestLoadRules(t *testing.T) {
+	t.Log("\nIt tests the validation of a map with the rules of a YAML file\n")
+	ruleSet, err := LoadRules(strings.NewReader(rulesTest), "yaml")
+	if err != nil {
+		t.Fatalf("\nReceived: %v.\nShould be: nil.\n", err)
+	}
+	data := map[string]interface{}{
+		"age":     float64(17),
+		"tags":    []interface{}{"go", "Go"},
+		"address": map[string]interface{}{"zip": "123"},
+	}
+	expected := []error{
+		errors.New("The address.zip cannot have length different than 8, the length of informed value was \"123\"."),
+		errors.New("The age cannot be less than 18, the value informed was 17."),
+		errors.New("The name is required."),
+		errors.New("The tags[1] is not a valid regex:^[a-z]*$ , the informed value was Go."),
+	}
+	if errorsReceived := ruleSet.Validate(data, nil); !reflect.DeepEqual(errorsReceived, expected) {
+		t.Errorf("\nReceived: %v.\nShould be: %v.\n", errorsReceived, expected)
+	}
+	ruleSet, err = LoadRules(strings.NewReader(`{"addr": {"type": "object", "fields": {"street": {"type": "string"}, "zip": {"type": "string", "rules": "required_with:street"}}}}`), "json")
+	if err != nil {
+		t.Fatalf("\nReceived: %v.\nShould be: nil.\n", err)
+	}
+	data = map[string]interface{}{"addr": map[string]interface{}{"street": "x", "zip": ""}}
+	if errorsReceived := ruleSet.Validate(data, nil); len(errorsReceived) != 1 || !strings.HasPrefix(errorsReceived[0].Error(), "The addr.zip ") {
+		t.Log("\nTests the required_with rule in a nested field\n")
+		t.Errorf("\nReceived: %v.\nShould be: the error of addr.zip.\n", errorsReceived)
+	}
+	invalidRules := map[string]string{
+		`{"age": {"type": "integer", "rules": "email"}}`:                                                     "Error: The field age has invalid rules: the rule email does not exist in the numeric validator",
+		`{"age": {"type": "int"}}`:                                                                           "Error: The field age has the type int, use string, number, integer, boolean, array or object",
+		`{"name": {"type": "string", "rules": "min:'3"}}`:                                                    `Error: The field name has invalid rules: Error: Invalid tag "min:'3" at position 4: the quote is not closed`,
+		`{"name": {"type": "string", "rule": "required"}}`:                                                   `Error: The rules are not valid: json: unknown field "rule"`,
+		`{"addr": {"type": "object", "fields": {"zip": {"type": "string", "rules": "omitempty|length:8"}}}}`: "Error: The field addr.zip has the modifier omitempty, the modifiers cannot be used in rules files",
+	}
+	for rules, expectedError := range invalidRules {
+		if _, err := LoadRules(strings.NewReader(rules), "json"); err == nil || err.Error() != expectedError {
+			t.Log("\nTests invalid rules\n")
+			t.Errorf("\nReceived: %v.\nShould be: %v.\n", err, expectedError)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package validator
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// SelfValidator - Interface of the value objects that validate themselves, like an Email or a Money type.
+// The fields with a type that implements it, or with a list of these types, are validated by the
+// Validate method, without a rule in the tag. The errors returned have the path of the field, the
+// validator key type "self" and the rule "validate".
+type SelfValidator interface {
+	Validate() error
+}
+
+var (
+	// selfValidatorType - type of the SelfValidator interface
+	selfValidatorType = reflect.TypeOf((*SelfValidator)(nil)).Elem()
+)
+
+// validateSelf - call the Validate method of the field, or of the items of the field when it's a list,
+// the nil values are not validated
+func validateSelf(field reflect.Value, messageInput MessageInput) (validationErrors ValidationErrors) {
+	if selfValidator, ok := getImplementation(field, selfValidatorType); ok {
+		if err := selfValidator.(SelfValidator).Validate(); err != nil {
+			messageInput.ValidatorKeyType, messageInput.RuleName, messageInput.RuleValue = "self", "validate", ""
+			validationErrors = append(validationErrors, newFieldError(messageInput, err))
+		}
+		return validationErrors
+	} else if field.Kind() != reflect.Slice && field.Kind() != reflect.Array {
+		return nil
+	}
+	path := messageInput.Path
+	for i := 0; i < field.Len(); i++ {
+		messageInput.Path = fmt.Sprintf("%s[%d]", path, i)
+		messageInput.FieldValue = getFieldValue(field.Index(i))
+		validationErrors = append(validationErrors, validateSelf(field.Index(i), messageInput)...)
+	}
+	return validationErrors
+}
+
+// isSelfValidatorType - check if the type, or the type of the items of a list, implements SelfValidator
+// with a value or a pointer receiver
+func isSelfValidatorType(fieldType reflect.Type) bool {
+	for fieldType.Kind() == reflect.Ptr {
+		fieldType = fieldType.Elem()
+	}
+	if fieldType.Kind() == reflect.Slice || fieldType.Kind() == reflect.Array {
+		return isSelfValidatorType(fieldType.Elem())
+	}
+	return fieldType.Implements(selfValidatorType) || reflect.PtrTo(fieldType).Implements(selfValidatorType)
+}
+
+// getImplementation - returns the field as an interface{} when its type implements the interfaceType,
+// or a pointer to a copy of the field when only the pointer type implements it. The nil values and the
+// unexported fields are not returned.
+func getImplementation(field reflect.Value, interfaceType reflect.Type) (interface{}, bool) {
+	if !field.IsValid() || !field.CanInterface() || isNilReference(field) {
+		return nil, false
+	} else if field.Type().Implements(interfaceType) {
+		return field.Interface(), true
+	} else if !reflect.PtrTo(field.Type()).Implements(interfaceType) {
+		return nil, false
+	} else if field.CanAddr() {
+		return field.Addr().Interface(), true
+	}
+	// the methods have a pointer receiver, the value is copied to be addressable
+	pointer := reflect.New(field.Type())
+	pointer.Elem().Set(field)
+	return pointer.Interface(), true
+}
@@ -0,0 +1,185 @@
+package validator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TagRule - Rule of a tag parsed by ParseTag. Value is the text after the ':' without quotes and escapes,
+// Params are the parts of the value separated by commas, and Pos is the position of the rule in the tag.
+type TagRule struct {
+	Name   string
+	Value  string
+	Params []string
+	Pos    int
+}
+
+// TagSyntaxError - Error of a malformed tag, with the position of the error in the tag
+type TagSyntaxError struct {
+	Tag     string
+	Pos     int
+	Message string
+}
+
+// Error - Returns the message of the error with the position
+func (err *TagSyntaxError) Error() string {
+	return fmt.Sprintf("Error: Invalid tag %q at position %d: %s", err.Tag, err.Pos, err.Message)
+}
+
+// ParseTag - Parse the rules of a tag, like "min:3|max:20". The rules are separated by '|' and the value
+// of a rule comes after the first ':', so it can have other ':'. Parts of the value between single
+// quotes are kept as they are, like "regex:'^(a|b) c$'", and outside the quotes the pipe, the comma and
+// the quote can be escaped with a backslash. The spaces around the names and the params are ignored.
+func ParseTag(tag string) (rules []TagRule, err error) {
+	if strings.TrimSpace(tag) == "" {
+		return nil, nil
+	}
+	parser := &tagParser{tag: tag}
+	for {
+		rule, err := parser.parseRule()
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+		if parser.pos >= len(tag) {
+			return rules, nil
+		}
+		// skip the '|'
+		parser.pos++
+	}
+}
+
+// mustParseTag - parse the rules of a tag, a panic is throwed if the tag is malformed
+func mustParseTag(tag string) []TagRule {
+	rules, err := ParseTag(tag)
+	if err != nil {
+		panic(err.Error())
+	}
+	return rules
+}
+
+// quoteTagParam - returns the param quoted when it has characters of the tag syntax
+func quoteTagParam(param string) string {
+	if !strings.ContainsAny(param, "|,' \t\\") {
+		return param
+	}
+	return "'" + strings.Replace(param, "'", "\\'", -1) + "'"
+}
+
+// tagParser - state of the parse of a tag, pos is the position of the next character
+type tagParser struct {
+	tag string
+	pos int
+}
+
+// errorf - returns a TagSyntaxError in the current position
+func (parser *tagParser) errorf(format string, args ...interface{}) error {
+	return &TagSyntaxError{Tag: parser.tag, Pos: parser.pos, Message: fmt.Sprintf(format, args...)}
+}
+
+// skipSpaces - move the position to the next character that is not a space
+func (parser *tagParser) skipSpaces() {
+	for parser.pos < len(parser.tag) && (parser.tag[parser.pos] == ' ' || parser.tag[parser.pos] == '\t') {
+		parser.pos++
+	}
+}
+
+// parseRule - parse the rule in the position, until the end of the tag or the next '|'
+func (parser *tagParser) parseRule() (TagRule, error) {
+	parser.skipSpaces()
+	rule := TagRule{Pos: parser.pos}
+	for parser.pos < len(parser.tag) && isTagNameChar(parser.tag[parser.pos]) {
+		parser.pos++
+	}
+	rule.Name = parser.tag[rule.Pos:parser.pos]
+	if rule.Name == "" {
+		if parser.pos < len(parser.tag) && parser.tag[parser.pos] != '|' {
+			return rule, parser.errorf("unexpected %q, expected a rule name", parser.tag[parser.pos])
+		}
+		return rule, parser.errorf("expected a rule name")
+	}
+	parser.skipSpaces()
+	if parser.pos == len(parser.tag) || parser.tag[parser.pos] == '|' {
+		return rule, nil
+	} else if parser.tag[parser.pos] != ':' {
+		return rule, parser.errorf("unexpected %q after the rule %s", parser.tag[parser.pos], rule.Name)
+	}
+	parser.pos++
+	return rule, parser.parseValue(&rule)
+}
+
+// parseValue - parse the value and the params of the rule, until the end of the tag or the next '|'
+// outside quotes
+func (parser *tagParser) parseValue(rule *TagRule) error {
+	var value, param tagText
+	quotePos := -1
+	for ; parser.pos < len(parser.tag); parser.pos++ {
+		char := parser.tag[parser.pos]
+		quoted := quotePos >= 0
+		if char == '\\' && parser.pos+1 < len(parser.tag) && (parser.tag[parser.pos+1] == '\'' || (!quoted && strings.IndexByte("|,", parser.tag[parser.pos+1]) >= 0)) {
+			// escaped character
+			parser.pos++
+			value.write(parser.tag[parser.pos], true)
+			param.write(parser.tag[parser.pos], true)
+		} else if char == '\'' && quoted {
+			quotePos = -1
+		} else if char == '\'' {
+			quotePos = parser.pos
+			value.start()
+			param.start()
+		} else if char == '|' && !quoted {
+			break
+		} else if char == ',' && !quoted {
+			value.write(char, true)
+			rule.Params = append(rule.Params, param.String())
+			param = tagText{}
+		} else {
+			value.write(char, quoted)
+			param.write(char, quoted)
+		}
+	}
+	if quotePos >= 0 {
+		parser.pos = quotePos
+		return parser.errorf("the quote is not closed")
+	}
+	rule.Value = value.String()
+	rule.Params = append(rule.Params, param.String())
+	return nil
+}
+
+// isTagNameChar - check if the character can be used in the name of a rule
+func isTagNameChar(char byte) bool {
+	return ('a' <= char && char <= 'z') || ('A' <= char && char <= 'Z') || ('0' <= char && char <= '9') || char == '_' || char == '-' || char == '.'
+}
+
+// tagText - text of a value or a param, the spaces outside quotes at the start and at the end are ignored
+type tagText struct {
+	text    strings.Builder
+	spaces  string
+	started bool
+}
+
+// start - mark the start of the text, so the next spaces are kept
+func (text *tagText) start() {
+	text.text.WriteString(text.spaces)
+	text.spaces = ""
+	text.started = true
+}
+
+// write - add a character to the text, the spaces outside quotes are added only if other character
+// comes after them
+func (text *tagText) write(char byte, quoted bool) {
+	if !quoted && (char == ' ' || char == '\t') {
+		if text.started {
+			text.spaces += string(char)
+		}
+		return
+	}
+	text.start()
+	text.text.WriteByte(char)
+}
+
+// String - Returns the text without the spaces at the end
+func (text *tagText) String() string {
+	return text.text.String()
+}
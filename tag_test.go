@@ -0,0 +1,67 @@
+package validator
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseTag(t *testing.T) {
+	t.Log("\nIt tests the rules of the tags\n")
+	tests := []struct {
+		tag   string
+		rules []TagRule
+	}{
+		{"", nil},
+		{"required | min: 3", []TagRule{{"required", "", nil, 0}, {"min", "3", []string{"3"}, 11}}},
+		{`regex:^\d{2}:\d{2}$`, []TagRule{{"regex", `^\d{2}:\d{2}$`, []string{`^\d{2}:\d{2}$`}, 0}}},
+		{"regex:'^(a|b) c$'|max:9", []TagRule{{"regex", "^(a|b) c$", []string{"^(a|b) c$"}, 0}, {"max", "9", []string{"9"}, 18}}},
+		{`regex:^a\|b$`, []TagRule{{"regex", "^a|b$", []string{"^a|b$"}, 0}}},
+		{`in:'a, b', c\,d,'it\'s'`, []TagRule{{"in", "a, b, c,d,it's", []string{"a, b", "c,d", "it's"}, 0}}},
+	}
+	for _, test := range tests {
+		if rules, err := ParseTag(test.tag); err != nil || !reflect.DeepEqual(rules, test.rules) {
+			t.Errorf("\nReceived: %#v, %v.\nShould be: %#v.\n", rules, err, test.rules)
+		}
+	}
+}
+
+func TestParseTagErrors(t *testing.T) {
+	t.Log("\nIt tests the positions of the errors of malformed tags\n")
+	tests := []struct {
+		tag string
+		pos int
+	}{
+		{"required||min:3", 9},
+		{"min:3|", 6},
+		{"regex:'^a$|max:3", 6},
+		{"min 3", 4},
+		{"required|?", 9},
+	}
+	for _, test := range tests {
+		_, err := ParseTag(test.tag)
+		var syntaxError *TagSyntaxError
+		if !errors.As(err, &syntaxError) || syntaxError.Pos != test.pos {
+			t.Errorf("\nReceived: %v.\nShould be: an error at position %d of %q.\n", err, test.pos, test.tag)
+		}
+	}
+	if err := RegisterAlias("broken", "min:'3"); err == nil {
+		t.Log("\nTests an alias with a malformed tag\n")
+		t.Errorf("\nReceived: nil.\nShould be: an error.\n")
+	}
+}
+
+func TestValidateQuotedRegex(t *testing.T) {
+	type Schedule struct {
+		Time string `json:"time" struct-validator:"regex:^\\d{2}:\\d{2}$"`
+		Day  string `json:"day" struct-validator:"regex:'^(mon|tue) [0-9]+$'"`
+	}
+	t.Log("\nIt tests regex rules with ':', '|' and spaces\n")
+	if errorsReceived := Validate(Schedule{"10:30", "mon 1"}, nil); errorsReceived != nil {
+		t.Errorf("\nReceived: %v.\nShould be: nil.\n", errorsReceived)
+	}
+	expected := []error{errors.New("The day is not a valid regex:^(mon|tue) [0-9]+$ , the informed value was wed 1.")}
+	if errorsReceived := Validate(Schedule{"10:30", "wed 1"}, nil); !reflect.DeepEqual(errorsReceived, expected) {
+		t.Errorf("\nReceived: %v.\nShould be: %v.\n", errorsReceived, expected)
+	}
+}
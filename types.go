@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 )
 
 const (
@@ -45,12 +46,21 @@ const (
 // MessageInput - Input struct used
 type MessageInput struct {
 	FieldName          string
+	Label              string
+	Path               string
 	FieldType          reflect.Type
 	ValidatorKeyType   string
 	FieldValue         interface{}
+	PreviousValue      interface{}
 	RuleName           string
 	RuleValue          string
+	RuleParams         []string
+	Alias              string
 	CustomMessages     map[string]map[string]string
+	TagMessages        map[string]string
+	Sensitive          bool
+	Locale             string
+	Renderer           MessageRenderer
 	OthersMessageInput []MessageInput
 }
 
@@ -123,6 +133,21 @@ func defineTypes() {
 			}
 			return GenerateErrorMessage(messageInput)
 		}
+		// min_chars and max_chars count the characters, like the minLength and maxLength of JSON Schema
+		types["string"]["min_chars"] = func(messageInput MessageInput) error {
+			PanicOnEmptyRuleValue("min_chars", messageInput.RuleValue)
+			if uint64(utf8.RuneCountInString(messageInput.FieldValue.(string))) >= GetUintRuleValueOrPanic(messageInput.RuleName, messageInput.RuleValue) {
+				return nil
+			}
+			return GenerateErrorMessage(messageInput)
+		}
+		types["string"]["max_chars"] = func(messageInput MessageInput) error {
+			PanicOnEmptyRuleValue("max_chars", messageInput.RuleValue)
+			if uint64(utf8.RuneCountInString(messageInput.FieldValue.(string))) <= GetUintRuleValueOrPanic(messageInput.RuleName, messageInput.RuleValue) {
+				return nil
+			}
+			return GenerateErrorMessage(messageInput)
+		}
 		types["string"]["length"] = func(messageInput MessageInput) error {
 			PanicOnEmptyRuleValue("max", messageInput.RuleValue)
 			if uint64(len(messageInput.FieldValue.(string))) == GetUintRuleValueOrPanic(messageInput.RuleName, messageInput.RuleValue) {
@@ -172,7 +197,10 @@ func defineTypes() {
 			return MatchRegex(messageInput, messageInput.RuleValue)
 		}
 		types["string"]["required"] = func(messageInput MessageInput) error {
-			messageInput.RuleName = "min"
+			// use the messages of min, unless there's a custom message for required
+			if _, ok := getCustomMessage(messageInput); !ok {
+				messageInput.RuleName = "min"
+			}
 			messageInput.RuleValue = "1"
 			return types["string"]["min"](messageInput)
 		}
@@ -314,7 +342,10 @@ func defineTypes() {
 			return nil
 		}
 		types["array"]["required"] = func(messageInput MessageInput) error {
-			messageInput.RuleName = "min"
+			// use the messages of min, unless there's a custom message for required
+			if _, ok := getCustomMessage(messageInput); !ok {
+				messageInput.RuleName = "min"
+			}
 			messageInput.RuleValue = "1"
 			return types["array"]["min"](messageInput)
 		}
@@ -346,6 +377,67 @@ func defineTypes() {
 			return RequiredWithoutAll(messageInput)
 		}
 	}
+	//updates, the rules pass when there's no previous value
+	{
+		for _, validatorKeyType := range []string{"numeric", "string", "timestamp", "array"} {
+			types[validatorKeyType]["immutable"] = Immutable
+		}
+		types["numeric"]["increasing"] = func(messageInput MessageInput) error {
+			if messageInput.PreviousValue == nil {
+				return nil
+			}
+			fieldValue, errFieldValue := GetFloatFromInterface(messageInput.FieldValue)
+			previousValue, errPreviousValue := GetFloatFromInterface(messageInput.PreviousValue)
+			if errFieldValue != nil || errPreviousValue != nil {
+				//try with uint64
+				if fieldValue, err := GetUintFromInterface(messageInput.FieldValue); err != nil {
+					return err
+				} else if previousValue, err := GetUintFromInterface(messageInput.PreviousValue); err != nil {
+					return err
+				} else if fieldValue < previousValue {
+					return GenerateErrorMessage(messageInput)
+				}
+			} else if fieldValue < previousValue {
+				return GenerateErrorMessage(messageInput)
+			}
+			return nil
+		}
+		types["timestamp"]["increasing"] = func(messageInput MessageInput) error {
+			previousValue, ok := messageInput.PreviousValue.(time.Time)
+			if ok && messageInput.FieldValue.(time.Time).Before(previousValue) {
+				return GenerateErrorMessage(messageInput)
+			}
+			return nil
+		}
+		types["array"]["no_shrink"] = func(messageInput MessageInput) error {
+			if messageInput.PreviousValue == nil {
+				return nil
+			}
+			interfaceArrayFieldValue, errFieldValue := GetInterfaceArrayFromInterface(messageInput.FieldValue)
+			if errFieldValue != nil {
+				return errFieldValue
+			}
+			interfaceArrayPreviousValue, errPreviousValue := GetInterfaceArrayFromInterface(messageInput.PreviousValue)
+			if errPreviousValue != nil {
+				return errPreviousValue
+			}
+			if len(interfaceArrayFieldValue) < len(interfaceArrayPreviousValue) {
+				return GenerateErrorMessage(messageInput)
+			}
+			return nil
+		}
+	}
+}
+
+// Immutable - Returns an error when the field had a value different of the zero value in the previous
+// version of the struct, and the value changed
+func Immutable(messageInput MessageInput) error {
+	if messageInput.PreviousValue == nil || reflect.ValueOf(messageInput.PreviousValue).IsZero() {
+		return nil
+	} else if isSameValue(messageInput.PreviousValue, messageInput.FieldValue) {
+		return nil
+	}
+	return GenerateErrorMessage(messageInput)
 }
 
 // RequiredWithAll - Not Implement Description
@@ -504,7 +596,11 @@ func GetUintFromString(value string) (uint64, error) {
 // GetFieldsNamesFromRuleString - Try to get a time.Time from a rule value string, if possible, then a time.Time
 // is returned, and if not a panic is returned
 func GetFieldsNamesFromRuleString(value string) []string {
-	return strings.Split(value, ",")
+	fieldsNames := strings.Split(value, ",")
+	for i, fieldName := range fieldsNames {
+		fieldsNames[i] = strings.TrimSpace(fieldName)
+	}
+	return fieldsNames
 }
 
 // GetTimestampFromRuleString - Try to get a time.Time from a rule value string, if possible, then a time.Time
@@ -0,0 +1,131 @@
+package validator
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// ValidateUpdate - Validate only the fields of the new version of a struct that are different in the
+// old version, like ValidateFields with the paths of the changed fields, and returns these paths. The
+// fields of nested structs and of the items of lists with the same length are compared one by one, and
+// the path of a list with changed items is returned too, so the rules of the list are validated. The
+// rules immutable, increasing and no_shrink compare the value of the field with the old value.
+func ValidateUpdate(old interface{}, new interface{}, messages map[string]map[string]string) (changedFields []string, returnedErrors []error) {
+	changedFields, validationErrors := validateUpdate(old, new, options{messages: messages})
+	return changedFields, validationErrors.Errors()
+}
+
+// ValidateUpdate - same as the ValidateUpdate function, using the configuration of the validator
+func (validator *Validator) ValidateUpdate(old interface{}, new interface{}) (changedFields []string, returnedErrors []error) {
+	changedFields, validationErrors := validateUpdate(old, new, validator.options())
+	return changedFields, validationErrors.Errors()
+}
+
+// validateUpdate - validate the fields of new that changed, with the old values in the messages input
+func validateUpdate(old interface{}, new interface{}, opts options) (changedFields []string, validationErrors ValidationErrors) {
+	oldValue, newValue := reflect.ValueOf(old), reflect.ValueOf(new)
+	for oldValue.Kind() == reflect.Ptr && !oldValue.IsNil() {
+		oldValue = oldValue.Elem()
+	}
+	for newValue.Kind() == reflect.Ptr && !newValue.IsNil() {
+		newValue = newValue.Elem()
+	}
+	if old == nil || new == nil || oldValue.Kind() == reflect.Ptr || newValue.Kind() == reflect.Ptr {
+		return nil, append(validationErrors, FieldError{Err: errors.New("The interface passed is nil")})
+	} else if oldValue.Type() != newValue.Type() || newValue.Kind() != reflect.Struct {
+		return nil, append(validationErrors, FieldError{Err: fmt.Errorf("Error: The old and the new values have to be structs of the same type, received %s and %s", oldValue.Type(), newValue.Type())})
+	}
+	visited := make(map[visitedPointer]bool)
+	if pointer := reflect.ValueOf(new); pointer.Kind() == reflect.Ptr {
+		visited[visitedPointer{pointer.Pointer(), pointer.Type()}] = true
+	}
+	changedFields = getChangedFields(oldValue, newValue, "", visited)
+	if len(changedFields) == 0 {
+		return nil, nil
+	}
+	// the lists with changed items validate only their rules, the fields of the items are in their paths
+	var fieldsPaths, rulesPaths []string
+	for _, changedField := range changedFields {
+		if hasChangedItems(changedField, changedFields) {
+			rulesPaths = append(rulesPaths, changedField)
+		} else {
+			fieldsPaths = append(fieldsPaths, changedField)
+		}
+	}
+	opts.selection.include = getFieldsPaths(fieldsPaths)
+	opts.selection.rules = getFieldsPaths(rulesPaths)
+	opts.previous = oldValue
+	return changedFields, validate(newValue.Interface(), opts)
+}
+
+// hasChangedItems - check if some of the changed fields is an item of the list of the path
+func hasChangedItems(path string, changedFields []string) bool {
+	for _, changedField := range changedFields {
+		if strings.HasPrefix(changedField, path+"[") {
+			return true
+		}
+	}
+	return false
+}
+
+// getChangedFields - returns the paths of the exported fields of the structs that are different, the
+// path is the prefix of the paths and visited has the pointers to structs that are being compared
+func getChangedFields(oldValue reflect.Value, newValue reflect.Value, path string, visited map[visitedPointer]bool) (changedFields []string) {
+	for i := 0; i < newValue.NumField(); i++ {
+		structField := newValue.Type().Field(i)
+		if structField.PkgPath != "" {
+			continue
+		}
+		name := getJSONName(structField)
+		if name == "-" {
+			name = structField.Name
+		}
+		changedFields = append(changedFields, getChangedValues(oldValue.Field(i), newValue.Field(i), path+name, visited)...)
+	}
+	return changedFields
+}
+
+// getChangedValues - returns the paths of the changes of a field, or of an item of a list, the path is
+// its path. The structs pointed by a pointer that is already being compared, like in a cyclic list, are
+// not compared again.
+func getChangedValues(oldField reflect.Value, newField reflect.Value, path string, visited map[visitedPointer]bool) (changedFields []string) {
+	for oldField.Kind() == reflect.Ptr && newField.Kind() == reflect.Ptr && !oldField.IsNil() && !newField.IsNil() {
+		pointer := visitedPointer{newField.Pointer(), newField.Type()}
+		if visited[pointer] {
+			return nil
+		}
+		visited[pointer] = true
+		defer delete(visited, pointer)
+		oldField, newField = oldField.Elem(), newField.Elem()
+	}
+	isList := newField.Kind() == reflect.Slice || newField.Kind() == reflect.Array
+	// the values that validate themselves are compared as a whole
+	isNested := isNestedStruct(newField.Type()) && !isSelfValidatorType(newField.Type())
+	if newField.Kind() == reflect.Struct && isNested {
+		return getChangedFields(oldField, newField, path+".", visited)
+	} else if isList && isNested && oldField.Len() == newField.Len() {
+		// the items of the lists are compared one by one
+		for j := 0; j < newField.Len(); j++ {
+			changedFields = append(changedFields, getChangedValues(oldField.Index(j), newField.Index(j), fmt.Sprintf("%s[%d]", path, j), visited)...)
+		}
+		if len(changedFields) > 0 {
+			changedFields = append([]string{path}, changedFields...)
+		}
+		return changedFields
+	} else if !isSameValue(oldField.Interface(), newField.Interface()) {
+		return []string{path}
+	}
+	return nil
+}
+
+// isSameValue - check if the values are equal, the timestamps are compared by the instant they represent
+func isSameValue(oldValue interface{}, newValue interface{}) bool {
+	if oldTime, ok := oldValue.(time.Time); ok {
+		newTime, ok := newValue.(time.Time)
+		return ok && oldTime.Equal(newTime)
+	}
+	return reflect.DeepEqual(oldValue, newValue)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 )
 
 var (
@@ -17,6 +18,19 @@ var (
 	nativeValidators map[string][]string
 	// relation between golang type names and 'validators key types'
 	nativeValidatorsKeyType map[string]string
+	// errorCodes - relation between "<validator key type>.<rule name>" and the codes declared with
+	// AddCustomValidatorWithCode
+	errorCodes = make(map[string]string)
+	// modifiers - names used in the tag that change how the field is validated, they are not rules and
+	// are valid for every 'validator key type'
+	modifiers = map[string]bool{
+		"sensitive": true,
+		"redact":    true,
+		"bail":      true,
+		"omitempty": true,
+		"nullable":  true,
+		"sometimes": true,
+	}
 )
 
 func init() {
@@ -38,6 +52,14 @@ func init() {
 		"string":    "string",
 		"time.Time": "timestamp",
 		"array":     "array",
+		// database/sql null types
+		"sql.NullString":  "string",
+		"sql.NullInt64":   "numeric",
+		"sql.NullInt32":   "numeric",
+		"sql.NullInt16":   "numeric",
+		"sql.NullByte":    "numeric",
+		"sql.NullFloat64": "numeric",
+		"sql.NullTime":    "timestamp",
 	}
 	// fill nativeValidator using the 'type' relation
 	nativeValidators = make(map[string][]string, 0)
@@ -49,180 +71,482 @@ func init() {
 	}
 }
 
+// options - configuration of one validation
+type options struct {
+	messages map[string]map[string]string
+	locale   string
+	renderer MessageRenderer
+	// selection - paths of the fields validated and of the fields not validated
+	selection fieldsSelection
+	// bail - stop the validation of each field at its first failing rule
+	bail bool
+	// maxErrors - when greater than 0, the validation stops when the number of errors is reached
+	maxErrors int
+	// groups - active groups, the rules of their tags are validated with the rules of the TagName
+	groups []string
+	// previous - old version of the struct validated by ValidateUpdate, invalid in other validations
+	previous reflect.Value
+	// present - lower case paths of the keys present in the decoded input, nil when they are unknown
+	present map[string]bool
+	// normalize - normalize the struct pointed by the value with Sanitize before the validation
+	normalize bool
+	// nested - validate the fields of every nested struct, not only of the nested structs of the paths
+	nested bool
+	// visited - the pointers to structs that are being validated, so the cyclic structs are validated once
+	visited map[visitedPointer]bool
+}
+
+// visitedPointer - key of the pointers visited by the validation of nested structs
+type visitedPointer struct {
+	address     uintptr
+	pointerType reflect.Type
+}
+
+// Validator - Validator with its own configuration, like the locale of the messages. The zero value
+// validates like the Validate function.
+type Validator struct {
+	// Locale - Locale of the messages, like "pt-BR", an empty locale uses the DefaultLocale
+	Locale string
+	// Messages - Custom messages, like the messages argument of Validate
+	Messages map[string]map[string]string
+	// Renderer - Renderer of the messages, like the HTMLRenderer for messages written in HTML pages, a nil
+	// Renderer uses the TextRenderer
+	Renderer MessageRenderer
+	// StopOnFirstError - Stop the validation at the first error, the same as MaxErrors equal to 1
+	StopOnFirstError bool
+	// Bail - Stop the validation of each field at its first failing rule, like the "bail" modifier in the
+	// tag of every field
+	Bail bool
+	// MaxErrors - Stop the validation when the number of errors is reached, 0 means no limit
+	MaxErrors int
+	// Groups - Active groups, like "create", the rules of the tags of the groups, like
+	// "struct-validator-create", are validated with the rules of the TagName
+	Groups []string
+	// Normalize - Normalize the struct with the "normalize" tag before the validation, like Sanitize, the
+	// validated value has to be a pointer to a struct
+	Normalize bool
+	// Nested - Validate the fields of nested structs, pointers to structs and lists of structs too,
+	// without it only the nested fields selected by paths, like "address.zip", are validated
+	Nested bool
+}
+
 // Validate - will validate all structs with the tag "struct-validator" that you pass by argument
 func Validate(st interface{}, messages map[string]map[string]string) (returnedErrors []error) {
+	return ValidateDetailed(st, messages).Errors()
+}
+
+// ValidateWithLocale - same as Validate, but the messages are of the locale, like "pt-BR"
+func ValidateWithLocale(st interface{}, locale string, messages map[string]map[string]string) (returnedErrors []error) {
+	return validate(st, options{messages: messages, locale: locale}).Errors()
+}
+
+// ValidateDetailed - same as Validate, but the errors keep the field, path and rule that generated them
+func ValidateDetailed(st interface{}, messages map[string]map[string]string) ValidationErrors {
+	return validate(st, options{messages: messages})
+}
+
+// ValidateGroups - same as Validate, with the rules of the groups, like "create" or "update". The rules
+// of a group are in the tag with the group name after the TagName, like "struct-validator-create". The
+// group "msg" is reserved to the tag of the messages, like "struct-validator-msg".
+func ValidateGroups(st interface{}, groups []string, messages map[string]map[string]string) (returnedErrors []error) {
+	return validate(st, options{messages: messages, groups: groups}).Errors()
+}
+
+// ValidateFields - will validate only the fields, matched by the "json" tag name or by the field name,
+// ignoring the case. The fields of nested structs are matched by paths, like "address.zip", and the
+// items of lists by indexes, like "items[*].sku" or "items[0].sku".
+func ValidateFields(st interface{}, fields []string, messages map[string]map[string]string) (returnedErrors []error) {
+	return validateFields(st, fields, options{messages: messages}).Errors()
+}
+
+// ValidateExcept - will validate all fields, except the fields informed, matched like ValidateFields
+func ValidateExcept(st interface{}, fields []string, messages map[string]map[string]string) (returnedErrors []error) {
+	return validateExcept(st, fields, options{messages: messages}).Errors()
+}
+
+// Validate - same as the Validate function, using the configuration of the validator
+func (validator *Validator) Validate(st interface{}) (returnedErrors []error) {
+	return validate(st, validator.options()).Errors()
+}
+
+// ValidateDetailed - same as the ValidateDetailed function, using the configuration of the validator
+func (validator *Validator) ValidateDetailed(st interface{}) ValidationErrors {
+	return validate(st, validator.options())
+}
+
+// ValidateFields - same as the ValidateFields function, using the configuration of the validator
+func (validator *Validator) ValidateFields(st interface{}, fields []string) (returnedErrors []error) {
+	return validateFields(st, fields, validator.options()).Errors()
+}
+
+// ValidateExcept - same as the ValidateExcept function, using the configuration of the validator
+func (validator *Validator) ValidateExcept(st interface{}, fields []string) (returnedErrors []error) {
+	return validateExcept(st, fields, validator.options()).Errors()
+}
+
+// options - returns the options of a validation with the configuration of the validator
+func (validator *Validator) options() options {
+	opts := options{messages: validator.Messages, locale: validator.Locale, renderer: validator.Renderer, bail: validator.Bail, maxErrors: validator.MaxErrors, groups: validator.Groups, normalize: validator.Normalize, nested: validator.Nested}
+	if validator.StopOnFirstError {
+		opts.maxErrors = 1
+	}
+	return opts
+}
+
+// limitErrors - returns the first errors of validationErrors when the maxErrors of the options is
+// reached, and true if the validation has to stop
+func (opts options) limitErrors(validationErrors ValidationErrors) (ValidationErrors, bool) {
+	if opts.maxErrors > 0 && len(validationErrors) >= opts.maxErrors {
+		return validationErrors[:opts.maxErrors], true
+	}
+	return validationErrors, false
+}
+
+// validateFields - validate only the fields of the paths
+func validateFields(st interface{}, fields []string, opts options) (validationErrors ValidationErrors) {
+	if st == nil {
+		return append(validationErrors, FieldError{Err: errors.New("The interface passed is nil")})
+	}
+
+	if len(fields) == 0 {
+		return append(validationErrors, FieldError{Err: errors.New("The field \"fields\" cannot be empty")})
+	}
+	opts.selection.include = getFieldsPaths(fields)
+	return validate(st, opts)
+}
+
+// validateExcept - validate all fields, except the fields of the paths
+func validateExcept(st interface{}, fields []string, opts options) (validationErrors ValidationErrors) {
+	opts.selection.exclude = getFieldsPaths(fields)
+	return validate(st, opts)
+}
+
+// validate - validate the fields of the struct with the options
+func validate(st interface{}, opts options) (validationErrors ValidationErrors) {
 	if st == nil {
-		return append(returnedErrors, errors.New("The interface passed is nil"))
+		return append(validationErrors, FieldError{Err: errors.New("The interface passed is nil")})
+	} else if containsString(opts.groups, "msg") {
+		// the tag of the group would be the tag of the messages
+		return append(validationErrors, FieldError{Err: fmt.Errorf("Error: The group msg cannot be used, the %s-msg tag has the messages of the rules", TagName)})
+	} else if opts.normalize {
+		if err := Sanitize(st); err != nil {
+			return append(validationErrors, FieldError{Err: err})
+		}
 	}
-	flagTag := true
+	opts.selection.all = opts.nested
+	opts.visited = make(map[visitedPointer]bool)
 	stValue := reflect.ValueOf(st)
+	for stValue.Kind() == reflect.Ptr {
+		if stValue.IsNil() {
+			return append(validationErrors, FieldError{Err: errors.New("The interface passed is nil")})
+		}
+		opts.visited[visitedPointer{stValue.Pointer(), stValue.Type()}] = true
+		stValue = stValue.Elem()
+	}
+	if generatedValidator, ok := stValue.Interface().(GeneratedValidator); ok && opts.useGenerated() {
+		validation := &GeneratedValidation{stValue: stValue, opts: opts}
+		if generatedValidator.ValidatorValidate(validation) {
+			validationErrors, _ = opts.limitErrors(validation.validationErrors)
+			return validationErrors
+		}
+	}
+	validationErrors, hasTag := validateStruct(stValue, opts.previous, "", opts.selection, opts)
+	if limitedErrors, stop := opts.limitErrors(validationErrors); stop {
+		return limitedErrors
+	} else if !hasTag {
+		return append(validationErrors, FieldError{Err: errors.New("Not found TAG: " + TagName)})
+	}
+	return validationErrors
+}
+
+// validateStruct - validate the selected fields of the struct and of its nested structs, the path is
+// the prefix of the paths of the fields and previous is the old version of the struct, when it's valid.
+// The nested structs are validated with the nested option or when the paths select their fields.
+// Returns true if some validated field has the TagName.
+func validateStruct(stValue reflect.Value, previous reflect.Value, path string, selection fieldsSelection, opts options) (validationErrors ValidationErrors, hasTag bool) {
 	// mount message input list
-	messagesInput := make([]MessageInput, 0)
-	for i := 0; i < stValue.NumField(); i++ {
-		field := stValue.Field(i)
-		var interfaceValue interface{}
-		if fieldKind := field.Type().Kind(); (reflect.Int <= fieldKind && fieldKind <= reflect.Int64) || fieldKind == reflect.Float32 || fieldKind == reflect.Float64 {
-			if fieldKind == reflect.Float32 || fieldKind == reflect.Float64 {
-				interfaceValue = field.Float()
-			} else {
-				//convert int type to float64
-				interfaceValue = float64(field.Int())
-			}
-		} else if reflect.Uint <= fieldKind && fieldKind <= reflect.Uintptr {
-			//convert uint type to uint64
-			interfaceValue = field.Uint()
-		} else {
-			//anothers types
-			interfaceValue = field.Interface()
+	messagesInput := getMessagesInput(stValue, opts)
+	fieldsData := getStructFields(stValue.Type())
+	if previous.IsValid() {
+		for i := range messagesInput {
+			messagesInput[i].PreviousValue, _ = getRulesValue(previous.Field(i), fieldsData[i])
 		}
-		messagesInput = append(messagesInput, MessageInput{
-			FieldName:        stValue.Type().Field(i).Name,
-			FieldValue:       interfaceValue,
-			CustomMessages:   messages,
-			FieldType:        field.Type(),
-			ValidatorKeyType: getValidatorKeyType(field.Type().String()),
-		})
 	}
 	//get errors
 	for i := 0; i < stValue.NumField(); i++ {
-		_, tagLookup := stValue.Type().Field(i).Tag.Lookup(TagName)
-		if tagLookup {
-			flagTag = false
-		}
+		structField := stValue.Type().Field(i)
+		tags, tagLookup := getGroupsTags(structField, opts.groups)
+		// the fields that validate themselves need no tag
+		hasTag = hasTag || tagLookup || fieldsData[i].selfValidator
 		messagesInput[i].OthersMessageInput = messagesInput
-		tags := strings.Replace(stValue.Type().Field(i).Tag.Get(TagName), " ", "", -1)
-		// get validator key
-		if messagesInput[i].ValidatorKeyType == "" || len(tags) == 0 {
+		messagesInput[i].Path = path + messagesInput[i].Path
+		nestedSelection, rules, selected := selection.selectField(structField.Name, getJSONName(structField))
+		if !selected {
 			continue
 		}
-		//get errors
-		returnedErrors = append(returnedErrors, checkValidations(tags, messagesInput[i])...)
+		// the rules of a driver.Valuer are not checked when its value cannot be read
+		var valuerErrors ValidationErrors
+		if rules && fieldsData[i].valuer && len(strings.TrimSpace(tags)) > 0 && !skipValidations(tags, stValue.Field(i), messagesInput[i], opts.present) {
+			valuerErrors = validateValuer(stValue.Field(i), messagesInput[i])
+			validationErrors = append(validationErrors, valuerErrors...)
+		}
+		// get validator key
+		if rules && len(valuerErrors) == 0 && messagesInput[i].ValidatorKeyType != "" && len(strings.TrimSpace(tags)) > 0 && !skipValidations(tags, stValue.Field(i), messagesInput[i], opts.present) {
+			//get errors
+			validationErrors = append(validationErrors, checkValidations(tags, messagesInput[i], opts.bail, isNullValuer(stValue.Field(i)))...)
+		}
+		if rules && fieldsData[i].selfValidator && !skipValidations(tags, stValue.Field(i), messagesInput[i], opts.present) {
+			validationErrors = append(validationErrors, validateSelf(stValue.Field(i), messagesInput[i])...)
+		}
+		if structField.PkgPath == "" && nestedSelection.selectsNested() {
+			var previousField reflect.Value
+			if previous.IsValid() {
+				previousField = previous.Field(i)
+			}
+			nestedErrors, nestedTag := validateNested(stValue.Field(i), previousField, messagesInput[i].Path, nestedSelection, opts)
+			validationErrors, hasTag = append(validationErrors, nestedErrors...), hasTag || nestedTag
+		}
+		if limitedErrors, stop := opts.limitErrors(validationErrors); stop {
+			return limitedErrors, true
+		}
+	}
+	return validationErrors, hasTag
+}
+
+// validateNested - validate the fields of a nested struct, or of the structs of a list, like a
+// []Item. Other values have no nested fields. The previous is the old version of the value, when
+// it's valid. The structs pointed by a pointer that is already being validated, like in a cyclic
+// list, are not validated again.
+func validateNested(value reflect.Value, previous reflect.Value, path string, selection fieldsSelection, opts options) (validationErrors ValidationErrors, hasTag bool) {
+	for value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return nil, false
+		}
+		pointer := visitedPointer{value.Pointer(), value.Type()}
+		if opts.visited[pointer] {
+			return nil, false
+		}
+		opts.visited[pointer] = true
+		defer delete(opts.visited, pointer)
+		value = value.Elem()
 	}
-	if flagTag {
-		return append(returnedErrors, errors.New("Not found TAG: "+TagName))
+	for previous.IsValid() && previous.Kind() == reflect.Ptr {
+		if previous.IsNil() {
+			previous = reflect.Value{}
+		} else {
+			previous = previous.Elem()
+		}
+	}
+	if !isNestedStruct(value.Type()) {
+		return nil, false
+	} else if value.Kind() == reflect.Struct {
+		return validateStruct(value, previous, path+".", selection, opts)
+	}
+	for i := 0; i < value.Len(); i++ {
+		if itemSelection, ok := selection.selectIndex(i); ok {
+			var previousItem reflect.Value
+			if previous.IsValid() && i < previous.Len() {
+				previousItem = previous.Index(i)
+			}
+			itemErrors, itemTag := validateNested(value.Index(i), previousItem, fmt.Sprintf("%s[%d]", path, i), itemSelection, opts)
+			validationErrors, hasTag = append(validationErrors, itemErrors...), hasTag || itemTag
+			if limitedErrors, stop := opts.limitErrors(validationErrors); stop {
+				return limitedErrors, true
+			}
+		}
 	}
-	return returnedErrors
+	return validationErrors, hasTag
 }
 
-// ValidateFields - will validate all structs with the tag "struct-validator" that you pass by argument
-func ValidateFields(st interface{}, fields []string, messages map[string]map[string]string) (returnedErrors []error) {
-	if st == nil {
-		return append(returnedErrors, errors.New("The interface passed is nil"))
+// isNestedStruct - check if the type is a struct, or a list of structs, with fields validated by
+// validateNested, the timestamps and the driver.Valuer types, like sql.NullTime, are values
+func isNestedStruct(fieldType reflect.Type) bool {
+	if fieldType.Kind() == reflect.Slice || fieldType.Kind() == reflect.Array {
+		fieldType = fieldType.Elem()
+	}
+	for fieldType.Kind() == reflect.Ptr {
+		fieldType = fieldType.Elem()
 	}
+	return fieldType.Kind() == reflect.Struct && fieldType != reflect.TypeOf(time.Time{}) && !isValuerType(fieldType)
+}
 
-	if len(fields) == 0 {
-		return append(returnedErrors, errors.New("The field \"fields\" cannot be empty"))
+// getGroupsTags - returns the rules of the TagName and of the tags of the groups, separated by '|', and
+// true if the field has some of these tags
+func getGroupsTags(structField reflect.StructField, groups []string) (string, bool) {
+	tags, tagLookup := structField.Tag.Lookup(TagName)
+	for _, group := range groups {
+		groupTags, groupLookup := structField.Tag.Lookup(TagName + "-" + group)
+		if strings.TrimSpace(groupTags) == "" {
+			tagLookup = tagLookup || groupLookup
+			continue
+		} else if strings.TrimSpace(tags) != "" {
+			groupTags = tags + "|" + groupTags
+		}
+		tags, tagLookup = groupTags, true
 	}
-	flagTag := true
-	// Let's convert the array of fields to validate, to map of string and bool
-	namesMap := make(map[string]bool)
-	for _, field := range fields {
-		namesMap[strings.ToLower(field)] = true
+	return tags, tagLookup
+}
+
+// getMessagesInput - mount the message input of each field of the struct
+func getMessagesInput(stValue reflect.Value, opts options) []MessageInput {
+	messagesInput := make([]MessageInput, 0, stValue.NumField())
+	for i, fieldData := range getStructFields(stValue.Type()) {
+		interfaceValue, validatorKeyType := getRulesValue(stValue.Field(i), fieldData)
+		messagesInput = append(messagesInput, getMessageInput(stValue.Type(), fieldData, interfaceValue, validatorKeyType, opts))
 	}
+	return messagesInput
+}
 
-	stValue := reflect.ValueOf(st)
-	// mount message input list
-	messagesInput := make([]MessageInput, 0)
-	for i := 0; i < stValue.NumField(); i++ {
-		field := stValue.Field(i)
-		var interfaceValue interface{}
-		if fieldKind := field.Type().Kind(); (reflect.Int <= fieldKind && fieldKind <= reflect.Int64) || fieldKind == reflect.Float32 || fieldKind == reflect.Float64 {
-			if fieldKind == reflect.Float32 || fieldKind == reflect.Float64 {
-				interfaceValue = field.Float()
-			} else {
-				//convert int type to float64
-				interfaceValue = float64(field.Int())
-			}
-		} else if reflect.Uint <= fieldKind && fieldKind <= reflect.Uintptr {
-			//convert uint type to uint64
-			interfaceValue = field.Uint()
-		} else {
-			//anothers types
-			interfaceValue = field.Interface()
+// getMessageInput - mount the message input of a field of the struct type with the value used by the rules
+func getMessageInput(structType reflect.Type, fieldData structField, value interface{}, validatorKeyType string, opts options) MessageInput {
+	return MessageInput{
+		FieldName:        fieldData.structField.Name,
+		Label:            getFieldLabel(structType, fieldData.structField, opts.locale),
+		Path:             fieldData.path,
+		FieldValue:       value,
+		CustomMessages:   opts.messages,
+		TagMessages:      fieldData.tagMessages,
+		Sensitive:        fieldData.sensitive,
+		Locale:           opts.locale,
+		Renderer:         opts.renderer,
+		FieldType:        fieldData.structField.Type,
+		ValidatorKeyType: validatorKeyType,
+	}
+}
+
+// getFieldValue - returns the value of the field used by the rules, the integers and the floats as
+// float64 and the unsigned integers as uint64. The unexported fields of other types have a nil value.
+func getFieldValue(field reflect.Value) interface{} {
+	if fieldKind := field.Type().Kind(); (reflect.Int <= fieldKind && fieldKind <= reflect.Int64) || fieldKind == reflect.Float32 || fieldKind == reflect.Float64 {
+		if fieldKind == reflect.Float32 || fieldKind == reflect.Float64 {
+			return field.Float()
 		}
-		messagesInput = append(messagesInput, MessageInput{
-			FieldName:        stValue.Type().Field(i).Name,
-			FieldValue:       interfaceValue,
-			CustomMessages:   messages,
-			FieldType:        field.Type(),
-			ValidatorKeyType: getValidatorKeyType(field.Type().String()),
-		})
+		//convert int type to float64
+		return float64(field.Int())
+	} else if reflect.Uint <= fieldKind && fieldKind <= reflect.Uintptr {
+		//convert uint type to uint64
+		return field.Uint()
+	} else if field.CanInterface() {
+		//anothers types
+		return field.Interface()
 	}
+	return nil
+}
 
-	//get errors
-	if len(messagesInput) > 0 {
-		for i := 0; i < stValue.NumField(); i++ {
-			_, tagLookup := stValue.Type().Field(i).Tag.Lookup(TagName)
-			if tagLookup {
-				flagTag = false
-			}
-			messagesInput[i].OthersMessageInput = messagesInput
-			tags := strings.Replace(stValue.Type().Field(i).Tag.Get(TagName), " ", "", -1)
-			valueTagJSON, validTagJSON := stValue.Type().Field(i).Tag.Lookup("json")
-			if validTagJSON {
-				// get validator key
-				_, exist := namesMap[valueTagJSON]
-				if exist {
-					if messagesInput[i].ValidatorKeyType == "" || len(tags) == 0 || !exist {
-						continue
-					}
-				} else {
-					// get validator key
-					_, exist := namesMap[strings.ToLower(stValue.Type().Field(i).Name)]
-					if messagesInput[i].ValidatorKeyType == "" || len(tags) == 0 || !exist {
-						continue
-					}
-				}
-			} else {
-				// get validator key
-				_, exist := namesMap[strings.ToLower(stValue.Type().Field(i).Name)]
-				if messagesInput[i].ValidatorKeyType == "" || len(tags) == 0 || !exist {
-					continue
-				}
-			}
-			//get errors
-			returnedErrors = append(returnedErrors, checkValidations(tags, messagesInput[i])...)
+// getRulesValue - returns the value of the field used by the rules and its 'validator key type', the
+// rules of the pointers validate the pointed value and the rules of the driver.Valuer types, like
+// sql.NullString, validate the value returned by the Value method
+func getRulesValue(field reflect.Value, fieldData structField) (interface{}, string) {
+	if fieldData.valuer {
+		return getValuerValue(field, fieldData.validatorKeyType)
+	} else if field.Kind() == reflect.Ptr && fieldData.validatorKeyType != "" {
+		field = getPointedValue(field)
+	}
+	return getFieldValue(field), fieldData.validatorKeyType
+}
+
+// getPointedValue - returns the value pointed by the pointer, or the zero value of the pointed type
+// when the pointer is nil
+func getPointedValue(pointer reflect.Value) reflect.Value {
+	for pointer.Kind() == reflect.Ptr {
+		if pointer.IsNil() {
+			return reflect.Zero(pointer.Type().Elem())
 		}
+		pointer = pointer.Elem()
+	}
+	return pointer
+}
+
+// structField - data of a field of a struct that does not change between validations
+type structField struct {
+	structField      reflect.StructField
+	path             string
+	tagMessages      map[string]string
+	sensitive        bool
+	validatorKeyType string
+	// valuer - the field implements driver.Valuer, its 'validator key type' depends on the value
+	valuer bool
+	// selfValidator - the field, or the items of the field, implement SelfValidator
+	selfValidator bool
+}
+
+// structFieldsKey - key of the cache of the fields of the structs, the fields depend on the tag name
+type structFieldsKey struct {
+	structType reflect.Type
+	tagName    string
+}
+
+// getStructFields - returns the data of the fields of a struct type, read only once per type and tag name
+func getStructFields(structType reflect.Type) []structField {
+	key := structFieldsKey{structType, TagName}
+	if fields, ok := structsFields.Load(key); ok {
+		return fields.([]structField)
 	}
-	if flagTag {
-		return append(returnedErrors, errors.New("Not found TAG: "+TagName))
+	fields := make([]structField, structType.NumField())
+	for i := range fields {
+		fields[i] = structField{
+			structField:      structType.Field(i),
+			path:             getJSONName(structType.Field(i)),
+			tagMessages:      parseTagMessages(structType.Field(i).Tag.Get(TagName + "-msg")),
+			sensitive:        isSensitiveField(structType.Field(i)),
+			validatorKeyType: GetValidatorKeyType(structType.Field(i).Type.String()),
+			valuer:           isValuerType(structType.Field(i).Type),
+			selfValidator:    structType.Field(i).PkgPath == "" && isSelfValidatorType(structType.Field(i).Type),
+		}
 	}
-	return returnedErrors
+	structsFields.Store(key, fields)
+	return fields
 }
 
-// getValidatorKeyType - check the field type and returns the 'validator key type' associated to field type
-func getValidatorKeyType(typeName string) string {
+// GetValidatorKeyType - check the field type and returns the 'validator key type' associated to field type,
+// like "numeric" for "int64", the pointers have the 'validator key type' of the pointed type
+func GetValidatorKeyType(typeName string) string {
+	typeName = strings.TrimLeft(typeName, "*")
 	if parts := strings.Split(typeName, "[]"); len(parts) == 2 {
 		return nativeValidatorsKeyType["array"]
 	}
 	return nativeValidatorsKeyType[typeName]
 }
 
-// Will get the 'validator key type', get rules of field tag and get errors if they exist.
+// Will get the 'validator key type', get rules of field tag and get errors if they exist. With bail,
+// or the "bail" modifier in the tag, only the first failing rule returns an error. The absent values,
+// like an invalid sql.NullString, are validated only by the required rules.
 // A panic is throwed if the rule of 'messageInput' does not exists for the field 'validator key type'
-func checkValidations(tags string, messageInput MessageInput) (errors []error) {
+func checkValidations(tags string, messageInput MessageInput, bail bool, absent bool) (validationErrors ValidationErrors) {
+	rules := getTagRules(tags)
+	for _, rule := range rules {
+		if rule.Name == "bail" {
+			bail = true
+		}
+	}
 	// get rules from field
-	rules := strings.Split(tags, "|")
 	for _, rule := range rules {
-		//if rule has value
-		parts := strings.Split(strings.Replace(rule, " ", "", -1), ":")
-		messageInput.RuleName = parts[0]
-		if len(parts) == 2 {
-			messageInput.RuleValue = parts[1]
+		if modifiers[rule.Name] || (absent && !strings.HasPrefix(rule.Name, "required")) {
+			continue
 		}
+		messageInput.RuleName = rule.Name
+		messageInput.RuleValue = rule.Value
+		messageInput.RuleParams = rule.Params
+		messageInput.Alias = rule.Alias
 		//get errors
 		if types[messageInput.ValidatorKeyType][messageInput.RuleName] == nil {
 			panic(fmt.Sprintf("The rule '%s' does not exists in %s validator", messageInput.RuleName, messageInput.ValidatorKeyType))
 		}
 		if err := types[messageInput.ValidatorKeyType][messageInput.RuleName](messageInput); err != nil {
-			errors = append(errors, err)
+			validationErrors = append(validationErrors, newFieldError(messageInput, err))
+			if bail {
+				break
+			}
 		}
 	}
-	return errors
+	return validationErrors
 }
 
 // Will check if exists a native 'validator key type' and 'rule', and returns a error if exists
 func checkIfExistsNativeValidadorKeyTypeAndRuleName(validatorKeyType string, ruleName string) error {
+	if modifiers[ruleName] {
+		return fmt.Errorf("Error: The rule %s is a native modifier of every native type name, you cannot change this rule", ruleName)
+	}
 	//foreach validator
 	for k, v := range nativeValidators {
 		if validatorKeyType != k {
@@ -243,6 +567,8 @@ func checkIfExistsNativeValidadorKeyTypeAndRuleName(validatorKeyType string, rul
 func AddCustomValidator(typeName string, ruleName string, handler func(MessageInput) error) error {
 	if err := checkIfExistsNativeValidadorKeyTypeAndRuleName(typeName, ruleName); err != nil {
 		return err
+	} else if _, ok := aliases[ruleName]; ok {
+		return fmt.Errorf("Error: The rule %s is an alias, you cannot use this name", ruleName)
 	}
 	//if is a new typeName
 	if types[typeName] == nil {
@@ -252,6 +578,16 @@ func AddCustomValidator(typeName string, ruleName string, handler func(MessageIn
 	return nil
 }
 
+// AddCustomValidatorWithCode - Same as AddCustomValidator, the errors of the rule have the code informed
+// instead of "<typeName>.<ruleName>"
+func AddCustomValidatorWithCode(typeName string, ruleName string, code string, handler func(MessageInput) error) error {
+	if err := AddCustomValidator(typeName, ruleName, handler); err != nil {
+		return err
+	}
+	errorCodes[typeName+"."+ruleName] = code
+	return nil
+}
+
 // DelCustomValidator - Will remove one custom validator, this method don't permit change native validators
 // and returns a error when the 'typeName' and 'ruleName' are native validators
 func DelCustomValidator(typeName string, ruleName string) error {
@@ -259,6 +595,7 @@ func DelCustomValidator(typeName string, ruleName string) error {
 		return err
 	}
 	delete(types[typeName], ruleName)
+	delete(errorCodes, typeName+"."+ruleName)
 	if len(types[typeName]) == 0 {
 		delete(types, typeName)
 	}
@@ -1,8 +1,14 @@
 package validator
 
 import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"math"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 )
@@ -62,14 +68,14 @@ var (
 		[]string{"age", "id"},
 	}
 	errorsTest = [][]error{
-		[]error{errors.New("The ID cannot be less than 3, the value informed was 2."), errors.New(`The Name cannot have length less than 1, the informed value was "".`)},
-		[]error{errors.New("The Age cannot be greater than 20, the value informed was 21.")},
-		[]error{errors.New("The Email is not a valid required_without_all, because if all fields: (Site,JSON) are not filled, then Email needs to be filled.")},
-		[]error{errors.New("The ID cannot be greater than 20, the value informed was 40."), errors.New("The Age is over max value.")},
-		[]error{errors.New("The ID cannot be less than 3, the value informed was 2."), errors.New(`The Name cannot have length less than 1, the informed value was "".`), errors.New("The Age cannot be greater than 20, the value informed was 21."), errors.New(`The Email is not a valid email, the informed value was "as".`), errors.New(`The IPv4 is not a valid ipv4, the informed value was "as".`), errors.New(`The AlphaDashField is not a valid alpha_dash_space, the informed value was "&&&**%%///\\%s".`), errors.New(`The AlphaNumField is not a valid alpha_num_space, the informed value was "&&&**%%///\\".`)},
-		[]error{errors.New("The ID cannot be less than 3, the value informed was 0."), errors.New(`The Name cannot have length less than 1, the informed value was "".`), errors.New("The Age cannot be less than 3, the value informed was 0."), errors.New("The CreateAt have to be after or equals to 2018-6-18, the date informed was 0001-1-1."), errors.New("The Email is not a valid required_without_all, because if all fields: (Site,JSON) are not filled, then Email needs to be filled."), errors.New("The MyIntArray is not a valid required_without_all, because if all fields: (MyFloat32Array,MyUintptrArray) are not filled, then MyIntArray needs to be filled.")},
-		[]error{errors.New("The ID cannot be greater than 20, the value informed was 40."), errors.New("Invalid name.")},
-		[]error{errors.New("The ID cannot be less than 3, the value informed was 1."), errors.New("The Age cannot be greater than 20, the value informed was 21.")},
+		[]error{errors.New("The id cannot be less than 3, the value informed was 2."), errors.New(`The name cannot have length less than 1, the informed value was "".`)},
+		[]error{errors.New("The age cannot be greater than 20, the value informed was 21.")},
+		[]error{errors.New("The email is not a valid required_without_all, because if all fields: (Site,JSON) are not filled, then email needs to be filled.")},
+		[]error{errors.New("The id cannot be greater than 20, the value informed was 40."), errors.New("The Age is over max value.")},
+		[]error{errors.New("The id cannot be less than 3, the value informed was 2."), errors.New(`The name cannot have length less than 1, the informed value was "".`), errors.New("The age cannot be greater than 20, the value informed was 21."), errors.New(`The email is not a valid email, the informed value was "as".`), errors.New(`The ipv4 is not a valid ipv4, the informed value was "as".`), errors.New(`The alphaDash is not a valid alpha_dash_space, the informed value was "&&&**%%///\\%s".`), errors.New(`The alphaNum is not a valid alpha_num_space, the informed value was "&&&**%%///\\".`)},
+		[]error{errors.New("The id cannot be less than 3, the value informed was 0."), errors.New(`The name cannot have length less than 1, the informed value was "".`), errors.New("The age cannot be less than 3, the value informed was 0."), errors.New("The createAt have to be after or equals to 2018-6-18, the date informed was 0001-1-1."), errors.New("The email is not a valid required_without_all, because if all fields: (Site,JSON) are not filled, then email needs to be filled."), errors.New("The MyIntArray is not a valid required_without_all, because if all fields: (MyFloat32Array,MyUintptrArray) are not filled, then MyIntArray needs to be filled.")},
+		[]error{errors.New("The id cannot be greater than 20, the value informed was 40."), errors.New("Invalid name.")},
+		[]error{errors.New("The id cannot be less than 3, the value informed was 1."), errors.New("The age cannot be greater than 20, the value informed was 21.")},
 	}
 	messagesTest = map[string]map[string]string{
 		"*": map[string]string{
@@ -186,3 +192,652 @@ func TestSetTag(t *testing.T) {
 		t.Errorf("\nReceived: %v.\nShould be: nil.\n", errorsReceived)
 	}
 }
+
+func TestTagMessages(t *testing.T) {
+	SetTag("struct-validator")
+	type Account struct {
+		Name  string `json:"name" struct-validator:"required|min:3" struct-validator-msg:"required=The name is required|min=Name too short"`
+		Email string `json:"email" struct-validator:"email"`
+		Login string `json:"login" struct-validator:"min:3"`
+		Age   int64  `json:"age" struct-validator:"max:60"`
+	}
+	messages := map[string]map[string]string{
+		"*":       map[string]string{"max": "Generic max"},
+		"string":  map[string]string{"min": "String min"},
+		"email":   map[string]string{"email": "Invalid e-mail"},
+		"Age":     map[string]string{"max": "Not used"},
+		"Account": map[string]string{"min": "Not used"},
+	}
+	t.Log("\nIt tests the precedence of the messages of \"*\", of the tag, of the field, of the json name and of the validator key type\n")
+	expected := []error{errors.New("The name is required"), errors.New("Name too short"), errors.New("Invalid e-mail"), errors.New("String min"), errors.New("Generic max")}
+	if errorsReceived := Validate(Account{"", "a", "ab", 70}, messages); !reflect.DeepEqual(errorsReceived, expected) {
+		t.Errorf("\nReceived: %v.\nShould be: %v.\n", errorsReceived, expected)
+	}
+}
+
+func TestHTMLRenderer(t *testing.T) {
+	SetTag("struct-validator")
+	type Comment struct {
+		Author string `json:"author" struct-validator:"email"`
+	}
+	comment := Comment{`<script>alert("x")</script>`}
+	t.Log("\nIt tests if the values are escaped by the HTMLRenderer\n")
+	expected := []error{errors.New(`The author is not a valid email, the informed value was "&lt;script&gt;alert(&#34;x&#34;)&lt;/script&gt;".`)}
+	if errorsReceived := (&Validator{Renderer: HTMLRenderer{}}).Validate(comment); !reflect.DeepEqual(errorsReceived, expected) {
+		t.Errorf("\nReceived: %v.\nShould be: %v.\n", errorsReceived, expected)
+	}
+	expected = []error{errors.New(`The author is not a valid email, the informed value was "<script>alert("x")</script>".`)}
+	if errorsReceived := Validate(comment, nil); !reflect.DeepEqual(errorsReceived, expected) {
+		t.Log("\nTests the plain text messages\n")
+		t.Errorf("\nReceived: %v.\nShould be: %v.\n", errorsReceived, expected)
+	}
+}
+
+func TestSensitiveFields(t *testing.T) {
+	SetTag("struct-validator")
+	type Payment struct {
+		Password   string `json:"password" struct-validator:"min:12|sensitive"`
+		CardNumber string `json:"card_number" struct-validator:"alpha_num"`
+		Holder     string `json:"holder" struct-validator:"alpha_space"`
+	}
+	defer func() {
+		sensitiveFieldPatterns = sensitiveFieldPatterns[:0]
+		clearCaches()
+	}()
+	if err := AddSensitiveFieldPattern("(?i)card_?number"); err != nil {
+		t.Fatalf("\nReceived: %v.\nShould be: nil.\n", err)
+	}
+	t.Log("\nIt tests if the values of sensitive fields are masked in the messages\n")
+	expected := []error{
+		errors.New(`The password cannot have length less than 12, the informed value was "****".`),
+		errors.New(`The card_number is not a valid alpha_num, the informed value was "****1234".`),
+		errors.New(`The holder is not a valid alpha_space, the informed value was "J0hn".`),
+	}
+	if errorsReceived := Validate(Payment{"secret", "4111-1111-1111-1234", "J0hn"}, nil); !reflect.DeepEqual(errorsReceived, expected) {
+		t.Errorf("\nReceived: %v.\nShould be: %v.\n", errorsReceived, expected)
+	}
+	type Account struct {
+		Pin   int64   `json:"pin" struct-validator:"min:1000|sensitive"`
+		Codes []int64 `json:"codes" struct-validator:"min:2|sensitive"`
+	}
+	expected = []error{
+		errors.New("O campo pin não pode ser menor que 1.000, o valor informado foi ****."),
+		errors.New("O campo codes deve ter pelo menos 2 itens, o valor informado foi ****."),
+	}
+	if errorsReceived := ValidateWithLocale(Account{12, []int64{1}}, "pt-BR", nil); !reflect.DeepEqual(errorsReceived, expected) {
+		t.Log("\nTests the masked values in the functions of the templates\n")
+		t.Errorf("\nReceived: %v.\nShould be: %v.\n", errorsReceived, expected)
+	}
+	if err := AddSensitiveFieldPattern("("); err == nil {
+		t.Log("\nTests an invalid pattern\n")
+		t.Errorf("\nReceived: nil.\nShould be: an error.\n")
+	}
+}
+
+func TestErrorCodes(t *testing.T) {
+	SetTag("struct-validator")
+	type Signup struct {
+		Email    string `json:"email" struct-validator:"email"`
+		Age      int    `json:"age" struct-validator:"min:18"`
+		Username string `json:"username" struct-validator:"username"`
+	}
+	if err := AddCustomValidatorWithCode("string", "username", "user.username_taken", func(messageInput MessageInput) error {
+		return errors.New("The username is taken.")
+	}); err != nil {
+		t.Fatalf("\nReceived: %v.\nShould be: nil.\n", err)
+	}
+	defer DelCustomValidator("string", "username")
+	t.Log("\nIt tests the codes of the native and custom rules\n")
+	expected := []string{"string.email", "numeric.min", "user.username_taken"}
+	codesReceived := make([]string, 0)
+	for _, fieldError := range ValidateDetailed(Signup{"robert", 17, "robert"}, nil) {
+		codesReceived = append(codesReceived, fieldError.Code)
+	}
+	if !reflect.DeepEqual(codesReceived, expected) {
+		t.Errorf("\nReceived: %v.\nShould be: %v.\n", codesReceived, expected)
+	}
+	if code := GetErrorCode("string", "username"); code != "user.username_taken" {
+		t.Log("\nTests the code of the custom rule\n")
+		t.Errorf("\nReceived: %v.\nShould be: user.username_taken.\n", code)
+	}
+}
+
+func TestStopValidation(t *testing.T) {
+	SetTag("struct-validator")
+	type Account struct {
+		Name  string `json:"name" struct-validator:"required|min:3"`
+		Email string `json:"email" struct-validator:"bail|required|email"`
+		Age   int    `json:"age" struct-validator:"min:18|max:10"`
+	}
+	account := Account{"", "", 11}
+	t.Log("\nIt tests the bail modifier\n")
+	expected := []error{
+		errors.New(`The name cannot have length less than 1, the informed value was "".`),
+		errors.New(`The name cannot have length less than 3, the informed value was "".`),
+		errors.New(`The email cannot have length less than 1, the informed value was "".`),
+		errors.New("The age cannot be less than 18, the value informed was 11."),
+		errors.New("The age cannot be greater than 10, the value informed was 11."),
+	}
+	if errorsReceived := Validate(account, nil); !reflect.DeepEqual(errorsReceived, expected) {
+		t.Errorf("\nReceived: %v.\nShould be: %v.\n", errorsReceived, expected)
+	}
+	if errorsReceived := (&Validator{Bail: true}).Validate(account); !reflect.DeepEqual(errorsReceived, []error{expected[0], expected[2], expected[3]}) {
+		t.Log("\nTests the Bail of the validator\n")
+		t.Errorf("\nReceived: %v.\nShould be: %v.\n", errorsReceived, []error{expected[0], expected[2], expected[3]})
+	}
+	if errorsReceived := (&Validator{StopOnFirstError: true}).Validate(account); !reflect.DeepEqual(errorsReceived, expected[:1]) {
+		t.Log("\nTests the StopOnFirstError of the validator\n")
+		t.Errorf("\nReceived: %v.\nShould be: %v.\n", errorsReceived, expected[:1])
+	}
+	if errorsReceived := (&Validator{MaxErrors: 4}).Validate(account); !reflect.DeepEqual(errorsReceived, expected[:4]) {
+		t.Log("\nTests the MaxErrors of the validator\n")
+		t.Errorf("\nReceived: %v.\nShould be: %v.\n", errorsReceived, expected[:4])
+	}
+}
+
+func TestRegisterAlias(t *testing.T) {
+	SetTag("struct-validator")
+	type Profile struct {
+		Username string `json:"username" struct-validator:"username"`
+		Bio      string `json:"bio" struct-validator:"length_between:2,5"`
+	}
+	defer DelAlias("username")
+	defer DelAlias("length_between")
+	if err := RegisterAlias("username", "alpha_dash|min:3|max:32"); err != nil {
+		t.Fatalf("\nReceived: %v.\nShould be: nil.\n", err)
+	}
+	if err := RegisterAlias("length_between", "min:$1|max:$2"); err != nil {
+		t.Fatalf("\nReceived: %v.\nShould be: nil.\n", err)
+	}
+	t.Log("\nIt tests the rules of the aliases\n")
+	expected := ValidationErrors{
+		{FieldName: "Username", Path: "username", ValidatorKeyType: "string", RuleName: "alpha_dash", Alias: "username", Code: "string.alpha_dash", Err: errors.New(`The username is not a valid alpha_dash, the informed value was "a b".`)},
+		{FieldName: "Bio", Path: "bio", ValidatorKeyType: "string", RuleName: "max", RuleValue: "5", Alias: "length_between", Code: "string.max", Err: errors.New(`The bio cannot have length greater than 5, the informed value was "golang".`)},
+	}
+	if errorsReceived := ValidateDetailed(Profile{"a b", "golang"}, nil); !reflect.DeepEqual(errorsReceived, expected) {
+		t.Errorf("\nReceived: %#v.\nShould be: %#v.\n", errorsReceived, expected)
+	}
+	if err := RegisterAlias("email", "min:3"); err == nil {
+		t.Log("\nTests an alias with the name of a native rule\n")
+		t.Errorf("\nReceived: nil.\nShould be: an error.\n")
+	}
+	if err := AddCustomValidator("string", "username", func(MessageInput) error { return nil }); err == nil {
+		t.Log("\nTests a custom rule with the name of an alias\n")
+		t.Errorf("\nReceived: nil.\nShould be: an error.\n")
+	}
+}
+
+type generatedModel struct {
+	ID   int64  `json:"id" struct-validator:"min:3|max:20"`
+	Name string `json:"name" struct-validator:"required|alpha_space"`
+}
+
+// generatedCalls - number of validations of the generatedModel by its generated code
+var generatedCalls int
+
+// ValidatorValidate - like the code generated by validator-gen
+func (st generatedModel) ValidatorValidate(validation *GeneratedValidation) bool {
+	if TagName != "struct-validator" {
+		return false
+	}
+	generatedCalls++
+	if float64(st.ID) < 3 {
+		validation.Check(0, 0, float64(st.ID))
+	}
+	if float64(st.ID) > 20 {
+		validation.Check(0, 1, float64(st.ID))
+	}
+	if len(st.Name) < 1 {
+		validation.Check(1, 0, st.Name)
+	}
+	validation.Check(1, 1, st.Name)
+	return true
+}
+
+type reflectedModel struct {
+	ID   int64  `json:"id" struct-validator:"min:3|max:20"`
+	Name string `json:"name" struct-validator:"required|alpha_space"`
+}
+
+func TestGeneratedValidator(t *testing.T) {
+	SetTag("struct-validator")
+	generatedCalls = 0
+	t.Log("\nIt tests if the generated code is used and has the same errors of the reflection\n")
+	expected := ValidateDetailed(reflectedModel{40, "R2-D2"}, nil)
+	if errorsReceived := ValidateDetailed(&generatedModel{40, "R2-D2"}, nil); len(expected) != 2 || !reflect.DeepEqual(errorsReceived, expected) || generatedCalls != 1 {
+		t.Errorf("\nReceived: %v %d.\nShould be: %v 1.\n", errorsReceived, generatedCalls, expected)
+	}
+	expected = (&Validator{MaxErrors: 1}).ValidateDetailed(reflectedModel{40, "R2-D2"})
+	if errorsReceived := (&Validator{MaxErrors: 1}).ValidateDetailed(generatedModel{40, "R2-D2"}); len(expected) != 1 || !reflect.DeepEqual(errorsReceived, expected) || generatedCalls != 2 {
+		t.Log("\nTests the generated code with MaxErrors\n")
+		t.Errorf("\nReceived: %v %d.\nShould be: %v 2.\n", errorsReceived, generatedCalls, expected)
+	}
+	if ValidateFields(generatedModel{40, "R2-D2"}, []string{"name"}, nil); generatedCalls != 2 {
+		t.Log("\nTests the validation of paths by reflection\n")
+		t.Errorf("\nReceived: %d.\nShould be: 2.\n", generatedCalls)
+	}
+	SetTag("validate")
+	expectedErrors := []error{errors.New("Not found TAG: validate")}
+	if errorsReceived := Validate(generatedModel{40, "R2-D2"}, nil); !reflect.DeepEqual(errorsReceived, expectedErrors) || generatedCalls != 2 {
+		t.Log("\nTests the generated code of another tag\n")
+		t.Errorf("\nReceived: %v %d.\nShould be: %v 2.\n", errorsReceived, generatedCalls, expectedErrors)
+	}
+	SetTag("struct-validator")
+}
+
+func TestValidateGroups(t *testing.T) {
+	SetTag("struct-validator")
+	type Product struct {
+		ID   int64  `json:"id" struct-validator-create:"max:0" struct-validator-update:"min:1"`
+		Name string `json:"name" struct-validator:"min:3" struct-validator-create:"required"`
+	}
+	t.Log("\nIt tests the rules of the active groups\n")
+	expected := []error{
+		errors.New("The id cannot be greater than 0, the value informed was 7."),
+		errors.New(`The name cannot have length less than 3, the informed value was "".`),
+		errors.New(`The name cannot have length less than 1, the informed value was "".`),
+	}
+	if errorsReceived := ValidateGroups(Product{7, ""}, []string{"create"}, nil); !reflect.DeepEqual(errorsReceived, expected) {
+		t.Errorf("\nReceived: %v.\nShould be: %v.\n", errorsReceived, expected)
+	}
+	expected = []error{
+		errors.New("The id cannot be less than 1, the value informed was 0."),
+		errors.New(`The name cannot have length less than 3, the informed value was "".`),
+	}
+	if errorsReceived := (&Validator{Groups: []string{"update"}}).Validate(Product{0, ""}); !reflect.DeepEqual(errorsReceived, expected) {
+		t.Log("\nTests the Groups of the validator\n")
+		t.Errorf("\nReceived: %v.\nShould be: %v.\n", errorsReceived, expected)
+	}
+	if errorsReceived := Validate(Product{0, ""}, nil); !reflect.DeepEqual(errorsReceived, expected[1:]) {
+		t.Log("\nTests the validation without groups\n")
+		t.Errorf("\nReceived: %v.\nShould be: %v.\n", errorsReceived, expected[1:])
+	}
+	type Person struct {
+		Name string `json:"name" struct-validator:"required" struct-validator-msg:"required=The name is required"`
+	}
+	expected = []error{errors.New("Error: The group msg cannot be used, the struct-validator-msg tag has the messages of the rules")}
+	if errorsReceived := ValidateGroups(Person{}, []string{"msg"}, nil); !reflect.DeepEqual(errorsReceived, expected) {
+		t.Log("\nTests the reserved group msg\n")
+		t.Errorf("\nReceived: %v.\nShould be: %v.\n", errorsReceived, expected)
+	}
+}
+
+func TestValidateFieldsPaths(t *testing.T) {
+	SetTag("struct-validator")
+	type Address struct {
+		Street string `json:"street" struct-validator:"min:3"`
+		Zip    string `json:"zip_code,omitempty" struct-validator:"length:8"`
+	}
+	type Item struct {
+		SKU      string `json:"sku" struct-validator:"min:2"`
+		Quantity int    `json:"qty" struct-validator:"min:1"`
+	}
+	type Order struct {
+		Name    string   `json:"name" struct-validator:"min:3"`
+		Address *Address `json:"address"`
+		Items   []Item   `json:"items"`
+	}
+	order := Order{Name: "A", Address: &Address{"B", "123"}, Items: []Item{{"X", 1}, {"YY", 0}}}
+	streetError := errors.New(`The street cannot have length less than 3, the informed value was "B".`)
+	zipError := errors.New(`The zip_code cannot have length different than 8, the length of informed value was "123".`)
+	skuError := errors.New(`The sku cannot have length less than 2, the informed value was "X".`)
+	quantityError := errors.New("The qty cannot be less than 1, the value informed was 0.")
+	nameError := errors.New(`The name cannot have length less than 3, the informed value was "A".`)
+	tests := []struct {
+		description string
+		errors      []error
+		expected    []error
+	}{
+		{"the field of a nested struct by the json name", ValidateFields(order, []string{"address.zip_code"}, nil), []error{zipError}},
+		{"the nested struct with all its fields", ValidateFields(order, []string{"Address"}, nil), []error{streetError, zipError}},
+		{"the fields of all items", ValidateFields(order, []string{"items[*].sku"}, nil), []error{skuError}},
+		{"the fields of an item", ValidateFields(order, []string{"items[1].qty"}, nil), []error{quantityError}},
+		{"the fields of all items without index", ValidateFields(order, []string{"items.QUANTITY"}, nil), []error{quantityError}},
+		{"all fields except the nested fields", ValidateExcept(order, []string{"address.street", "items[0]", "items[*].qty"}, nil), []error{nameError, zipError}},
+		{"only the fields of the struct", Validate(order, nil), []error{nameError}},
+		{"all fields with the nested structs", (&Validator{Nested: true}).Validate(order), []error{nameError, streetError, zipError, skuError, quantityError}},
+	}
+	for _, test := range tests {
+		t.Log("\nIt tests " + test.description + "\n")
+		if !reflect.DeepEqual(test.errors, test.expected) {
+			t.Errorf("\nReceived: %v.\nShould be: %v.\n", test.errors, test.expected)
+		}
+	}
+	t.Log("\nIt tests the paths of the errors of nested fields\n")
+	expectedPaths := []string{"name", "address.street", "address.zip_code", "items[0].sku", "items[1].qty"}
+	receivedPaths := []string{}
+	for _, fieldError := range (&Validator{Nested: true}).ValidateDetailed(order) {
+		receivedPaths = append(receivedPaths, fieldError.Path)
+	}
+	if !reflect.DeepEqual(receivedPaths, expectedPaths) {
+		t.Errorf("\nReceived: %v.\nShould be: %v.\n", receivedPaths, expectedPaths)
+	}
+	type Customer struct {
+		Address Address `json:"address"`
+	}
+	t.Log("\nIt tests if the tags of the nested structs are not the tags of the struct\n")
+	expected := []error{errors.New("Not found TAG: struct-validator")}
+	if errorsReceived := Validate(Customer{Address{"B", "123"}}, nil); !reflect.DeepEqual(errorsReceived, expected) {
+		t.Errorf("\nReceived: %v.\nShould be: %v.\n", errorsReceived, expected)
+	}
+}
+
+type node struct {
+	Name string `json:"name" normalize:"trim" struct-validator:"min:3"`
+	Next *node  `json:"next"`
+}
+
+func TestValidateCyclicStructs(t *testing.T) {
+	SetTag("struct-validator")
+	first := &node{Name: "a"}
+	first.Next = &node{Name: "b", Next: first}
+	t.Log("\nIt tests if the structs of a cyclic list are validated once\n")
+	expected := []error{
+		errors.New(`The name cannot have length less than 3, the informed value was "a".`),
+		errors.New(`The name cannot have length less than 3, the informed value was "b".`),
+	}
+	if errorsReceived := (&Validator{Nested: true}).Validate(first); !reflect.DeepEqual(errorsReceived, expected) {
+		t.Errorf("\nReceived: %v.\nShould be: %v.\n", errorsReceived, expected)
+	}
+	if errorsReceived := ValidateFields(first, []string{"next.next.next.name"}, nil); !reflect.DeepEqual(errorsReceived, []error(nil)) {
+		t.Log("\nTests a path of the cycle\n")
+		t.Errorf("\nReceived: %v.\nShould be: nil.\n", errorsReceived)
+	}
+	first.Next.Name = " b "
+	if err := Sanitize(first); err != nil || first.Next.Name != "b" {
+		t.Log("\nTests the normalization of a cyclic list\n")
+		t.Errorf("\nReceived: %v %q.\nShould be: nil \"b\".\n", err, first.Next.Name)
+	}
+}
+
+func TestValidateUpdate(t *testing.T) {
+	SetTag("struct-validator")
+	type Item struct {
+		SKU string `json:"sku" struct-validator:"min:2"`
+	}
+	type Account struct {
+		Document string    `json:"document" struct-validator:"immutable"`
+		Name     string    `json:"name" struct-validator:"min:3"`
+		Logins   int       `json:"logins" struct-validator:"increasing"`
+		Tags     []string  `json:"tags" struct-validator:"no_shrink"`
+		Items    []Item    `json:"items"`
+		Created  time.Time `json:"created" struct-validator:"immutable"`
+	}
+	created := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	old := Account{"123", "Al", 5, []string{"a", "b"}, []Item{{"X"}, {"YY"}}, created}
+	t.Log("\nIt tests that only the changed fields are validated\n")
+	new := old
+	new.Items = []Item{{"X"}, {"Z"}}
+	changedFields, errorsReceived := ValidateUpdate(old, &new, nil)
+	expectedFields := []string{"items", "items[1].sku"}
+	expected := []error{errors.New(`The sku cannot have length less than 2, the informed value was "Z".`)}
+	if !reflect.DeepEqual(changedFields, expectedFields) || !reflect.DeepEqual(errorsReceived, expected) {
+		t.Errorf("\nReceived: %v %v.\nShould be: %v %v.\n", changedFields, errorsReceived, expectedFields, expected)
+	}
+	t.Log("\nIt tests the rules that compare the previous value\n")
+	new = Account{"456", "Al", 4, []string{"a"}, old.Items, created.In(time.FixedZone("BRT", -3*3600))}
+	changedFields, errorsReceived = ValidateUpdate(old, new, nil)
+	expectedFields = []string{"document", "logins", "tags"}
+	expected = []error{
+		errors.New(`The document cannot be changed, the previous value was "123" and the informed value was "456".`),
+		errors.New("The logins cannot be less than the previous value 5, the value informed was 4."),
+		errors.New("The tags cannot have less items than the previous value [a b], the value informed was [a]."),
+	}
+	if !reflect.DeepEqual(changedFields, expectedFields) || !reflect.DeepEqual(errorsReceived, expected) {
+		t.Errorf("\nReceived: %v %v.\nShould be: %v %v.\n", changedFields, errorsReceived, expectedFields, expected)
+	}
+	t.Log("\nIt tests that an empty immutable field can be set\n")
+	old.Document = ""
+	if changedFields, errorsReceived = ValidateUpdate(old, Account{"456", "Al", 5, old.Tags, old.Items, created}, nil); errorsReceived != nil {
+		t.Errorf("\nReceived: %v.\nShould be: nil.\n", errorsReceived)
+	}
+	t.Log("\nIt tests that the rules pass without a previous value\n")
+	if errorsReceived = Validate(Account{"456", "Ana", 4, nil, nil, created}, nil); errorsReceived != nil {
+		t.Errorf("\nReceived: %v.\nShould be: nil.\n", errorsReceived)
+	}
+	t.Log("\nIt tests values of different types\n")
+	expected = []error{errors.New("Error: The old and the new values have to be structs of the same type, received validator.Account and validator.Item")}
+	if _, errorsReceived = ValidateUpdate(old, Item{}, nil); !reflect.DeepEqual(errorsReceived, expected) {
+		t.Errorf("\nReceived: %v.\nShould be: %v.\n", errorsReceived, expected)
+	}
+	t.Log("\nIt tests the rules of a list with changed items\n")
+	type Order struct {
+		Items []Item `json:"items" struct-validator:"immutable"`
+	}
+	changedFields, errorsReceived = ValidateUpdate(Order{[]Item{{"XX"}, {"YY"}}}, Order{[]Item{{"ZZ"}, {"YY"}}}, nil)
+	expectedFields = []string{"items", "items[0].sku"}
+	expected = []error{errors.New("The items cannot be changed, the previous value was [{XX} {YY}] and the value informed was [{ZZ} {YY}].")}
+	if !reflect.DeepEqual(changedFields, expectedFields) || !reflect.DeepEqual(errorsReceived, expected) {
+		t.Errorf("\nReceived: %v %v.\nShould be: %v %v.\n", changedFields, errorsReceived, expectedFields, expected)
+	}
+	t.Log("\nIt tests the update of cyclic structs\n")
+	oldNode, newNode := &node{Name: "abc"}, &node{Name: "ab"}
+	oldNode.Next, newNode.Next = oldNode, newNode
+	changedFields, errorsReceived = ValidateUpdate(oldNode, newNode, nil)
+	expectedFields = []string{"name"}
+	expected = []error{errors.New(`The name cannot have length less than 3, the informed value was "ab".`)}
+	if !reflect.DeepEqual(changedFields, expectedFields) || !reflect.DeepEqual(errorsReceived, expected) {
+		t.Errorf("\nReceived: %v %v.\nShould be: %v %v.\n", changedFields, errorsReceived, expectedFields, expected)
+	}
+}
+
+func TestOptionalModifiers(t *testing.T) {
+	SetTag("struct-validator")
+	type Profile struct {
+		Nickname string    `json:"nickname" struct-validator:"omitempty|min:3"`
+		Age      int       `json:"age" struct-validator:"omitempty|min:18"`
+		Tags     []string  `json:"tags" struct-validator:"omitempty|min:2"`
+		Birthday time.Time `json:"birthday" struct-validator:"omitempty|before:today"`
+		Bio      *string   `json:"bio" struct-validator:"nullable|min:10"`
+		Score    *float64  `json:"score" struct-validator:"min:1"`
+		Website  string    `json:"website" struct-validator:"sometimes|url"`
+	}
+	t.Log("\nIt tests that the empty and nil values are not validated\n")
+	expected := []error{errors.New("The score cannot be less than 1, the value informed was 0.")}
+	if errorsReceived := Validate(Profile{Website: "https://example.com/a/b"}, nil); !reflect.DeepEqual(errorsReceived, expected) {
+		t.Errorf("\nReceived: %v.\nShould be: %v.\n", errorsReceived, expected)
+	}
+	t.Log("\nIt tests that the values of the fields with modifiers are validated when they are filled\n")
+	bio, score := "short", 0.5
+	expected = []error{
+		errors.New(`The nickname cannot have length less than 3, the informed value was "ab".`),
+		errors.New("The age cannot be less than 18, the value informed was 17."),
+		errors.New("The tags cannot have length less than 2, the value informed was [a]."),
+		errors.New(`The bio cannot have length less than 10, the informed value was "short".`),
+		errors.New("The score cannot be less than 1, the value informed was 0.5."),
+		errors.New(`The website is not a valid url, the informed value was "x".`),
+	}
+	if errorsReceived := Validate(&Profile{"ab", 17, []string{"a"}, time.Time{}, &bio, &score, "x"}, nil); !reflect.DeepEqual(errorsReceived, expected) {
+		t.Errorf("\nReceived: %v.\nShould be: %v.\n", errorsReceived, expected)
+	}
+	t.Log("\nIt tests that the sometimes fields are validated only when their keys are present\n")
+	var profile Profile
+	validationErrors, err := ValidateJSON([]byte(`{"score": 2}`), &profile, nil)
+	if err != nil || validationErrors != nil {
+		t.Errorf("\nReceived: %v %v.\nShould be: nil.\n", validationErrors, err)
+	}
+	expected = []error{errors.New(`The website is not a valid url, the informed value was "x".`)}
+	if validationErrors, err = ValidateJSON([]byte(`{"score": 2, "website": "x"}`), &profile, nil); err != nil || !reflect.DeepEqual(validationErrors.Errors(), expected) {
+		t.Errorf("\nReceived: %v %v.\nShould be: %v.\n", validationErrors, err, expected)
+	}
+	if _, err = ValidateJSON([]byte(`{"score": "2"}`), &profile, nil); err == nil {
+		t.Log("\nTests an invalid document\n")
+		t.Errorf("\nReceived: nil.\nShould be: an error.\n")
+	}
+	present := make(map[string]bool)
+	expectedPresent := map[string]bool{"score": true, "address": true, "address.zip": true, "items": true, "items[0]": true, "items[0].sku": true, "items[1]": true}
+	if err = addJSONKeys(json.NewDecoder(strings.NewReader(`{"Score": 2, "address": {"zip": null}, "items": [{"sku": "x"}, 3]}`)), "", present); err != nil || !reflect.DeepEqual(present, expectedPresent) {
+		t.Log("\nTests the keys of a document\n")
+		t.Errorf("\nReceived: %v %v.\nShould be: %v.\n", present, err, expectedPresent)
+	}
+}
+
+// celsius - driver.Valuer with a pointer receiver, stored as a float
+type celsius struct {
+	degrees float64
+}
+
+// Value - Returns the degrees, null when they are below the absolute zero or an error when they are not a
+// number
+func (temperature *celsius) Value() (driver.Value, error) {
+	if math.IsNaN(temperature.degrees) {
+		return nil, errors.New("the degrees are not a number")
+	} else if temperature.degrees < -273.15 {
+		return nil, nil
+	}
+	return temperature.degrees, nil
+}
+
+func TestValuerFields(t *testing.T) {
+	SetTag("struct-validator")
+	type Reading struct {
+		Name        sql.NullString   `json:"name" struct-validator:"required|min:3"`
+		Note        sql.NullString   `json:"note" struct-validator:"min:3"`
+		Count       sql.NullInt64    `json:"count" struct-validator:"max:10"`
+		Ratio       *sql.NullFloat64 `json:"ratio" struct-validator:"min:0.5"`
+		Taken       sql.NullTime     `json:"taken" struct-validator:"before:today"`
+		Temperature celsius          `json:"temperature" struct-validator:"max:100"`
+	}
+	t.Log("\nIt tests that the null values are validated only by the required rules\n")
+	expected := []error{errors.New(`The name cannot have length less than 1, the informed value was "".`)}
+	if errorsReceived := Validate(Reading{Temperature: celsius{-300}}, nil); !reflect.DeepEqual(errorsReceived, expected) {
+		t.Errorf("\nReceived: %v.\nShould be: %v.\n", errorsReceived, expected)
+	}
+	t.Log("\nIt tests that the rules validate the values of the valid values\n")
+	tomorrow := time.Now().AddDate(0, 0, 1)
+	reading := Reading{
+		Name:        sql.NullString{String: "ab", Valid: true},
+		Note:        sql.NullString{String: "ok", Valid: true},
+		Count:       sql.NullInt64{Int64: 11, Valid: true},
+		Ratio:       &sql.NullFloat64{Float64: 0.25, Valid: true},
+		Taken:       sql.NullTime{Time: tomorrow, Valid: true},
+		Temperature: celsius{120},
+	}
+	errorsReceived := ValidateDetailed(reading, nil)
+	expectedRules := []string{"name.min", "note.min", "count.max", "ratio.min", "taken.before", "temperature.max"}
+	receivedRules := []string{}
+	for _, fieldError := range errorsReceived {
+		receivedRules = append(receivedRules, fieldError.Path+"."+fieldError.RuleName)
+	}
+	if !reflect.DeepEqual(receivedRules, expectedRules) {
+		t.Errorf("\nReceived: %v.\nShould be: %v.\n", receivedRules, expectedRules)
+	}
+	t.Log("\nIt tests the valuer fields with nullable\n")
+	type Optional struct {
+		Code sql.NullString `json:"code" struct-validator:"nullable|required"`
+	}
+	if errorsReceived := Validate(Optional{}, nil); errorsReceived != nil {
+		t.Errorf("\nReceived: %v.\nShould be: nil.\n", errorsReceived)
+	}
+	t.Log("\nIt tests the valuer fields with a value that cannot be read\n")
+	type Sensor struct {
+		Temperature celsius `json:"temperature" struct-validator:"nullable|max:100"`
+	}
+	expectedErrors := ValidationErrors{{FieldName: "Temperature", Path: "temperature", ValidatorKeyType: "valuer", RuleName: "value", Code: "valuer.value", Err: errors.New("the degrees are not a number")}}
+	if errorsReceived := ValidateDetailed(Sensor{celsius{math.NaN()}}, nil); !reflect.DeepEqual(errorsReceived, expectedErrors) {
+		t.Errorf("\nReceived: %#v.\nShould be: %#v.\n", errorsReceived, expectedErrors)
+	}
+}
+
+// email - value object that validates itself
+type email string
+
+// Validate - Returns an error when the e-mail has no "@"
+func (value email) Validate() error {
+	if !strings.Contains(string(value), "@") {
+		return fmt.Errorf("%q is not an e-mail", string(value))
+	}
+	return nil
+}
+
+// money - value object that validates itself with a pointer receiver
+type money struct {
+	Cents    int64
+	Currency string
+}
+
+// Validate - Returns an error when the currency is not informed
+func (value *money) Validate() error {
+	if len(value.Currency) != 3 {
+		return errors.New("the currency has to have 3 letters")
+	}
+	return nil
+}
+
+func TestSelfValidator(t *testing.T) {
+	SetTag("struct-validator")
+	type Invoice struct {
+		Contact  email   `json:"contact"`
+		Copies   []email `json:"copies"`
+		Total    money   `json:"total"`
+		Discount *money  `json:"discount"`
+		Backup   email   `json:"backup" struct-validator:"omitempty"`
+	}
+	invoice := Invoice{Contact: "nobody", Copies: []email{"a@b.com", "c"}, Total: money{100, "R$"}}
+	t.Log("\nIt tests that the fields are validated by their Validate method\n")
+	expected := []error{
+		errors.New(`"nobody" is not an e-mail`),
+		errors.New(`"c" is not an e-mail`),
+		errors.New("the currency has to have 3 letters"),
+	}
+	errorsReceived := ValidateDetailed(invoice, nil)
+	if !reflect.DeepEqual(errorsReceived.Errors(), expected) {
+		t.Errorf("\nReceived: %v.\nShould be: %v.\n", errorsReceived, expected)
+	}
+	t.Log("\nIt tests the paths and the rules of the errors\n")
+	expectedPaths := []string{"contact self.validate", "copies[1] self.validate", "total self.validate"}
+	receivedPaths := []string{}
+	for _, fieldError := range errorsReceived {
+		receivedPaths = append(receivedPaths, fieldError.Path+" "+fieldError.Code)
+	}
+	if !reflect.DeepEqual(receivedPaths, expectedPaths) {
+		t.Errorf("\nReceived: %v.\nShould be: %v.\n", receivedPaths, expectedPaths)
+	}
+	t.Log("\nIt tests that only the selected fields are validated\n")
+	if errorsReceived := ValidateExcept(&invoice, []string{"contact", "copies", "total"}, nil); errorsReceived != nil {
+		t.Errorf("\nReceived: %v.\nShould be: nil.\n", errorsReceived)
+	}
+}
+
+func TestValidateAndNormalize(t *testing.T) {
+	SetTag("struct-validator")
+	type Contact struct {
+		Phone string `json:"phone" normalize:"digits" struct-validator:"length:11"`
+	}
+	type Customer struct {
+		Email    string    `json:"email" normalize:"trim|lower" struct-validator:"email"`
+		Name     *string   `json:"name" normalize:"collapse_spaces" struct-validator:"min:3"`
+		Tags     []string  `json:"tags" normalize:"trim|upper"`
+		Contacts []Contact `json:"contacts"`
+		Code     string    `json:"code" normalize:"trim|reverse"`
+	}
+	if err := AddNormalizer("reverse", func(value string) string {
+		runes := []rune(value)
+		for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+			runes[i], runes[j] = runes[j], runes[i]
+		}
+		return string(runes)
+	}); err != nil {
+		t.Errorf("\nReceived: %v.\nShould be: nil.\n", err)
+	}
+	defer delete(normalizers, "reverse")
+	name := "  Ana   Maria "
+	customer := Customer{" Ana@Example.COM ", &name, []string{" a ", "b"}, []Contact{{"(11) 98765-4321"}}, " 123"}
+	t.Log("\nIt tests that the values are normalized before the rules\n")
+	if errorsReceived := ValidateAndNormalize(&customer, nil); errorsReceived != nil {
+		t.Errorf("\nReceived: %v.\nShould be: nil.\n", errorsReceived)
+	}
+	expectedName := "Ana Maria"
+	expected := Customer{"ana@example.com", &expectedName, []string{"A", "B"}, []Contact{{"11987654321"}}, "321"}
+	if !reflect.DeepEqual(customer, expected) {
+		t.Errorf("\nReceived: %v.\nShould be: %v.\n", customer, expected)
+	}
+	t.Log("\nIt tests that Sanitize needs a pointer\n")
+	if err := Sanitize(customer); err == nil {
+		t.Errorf("\nReceived: nil.\nShould be: an error.\n")
+	}
+	t.Log("\nIt tests the native normalizers\n")
+	if err := AddNormalizer("trim", strings.TrimSpace); err == nil {
+		t.Errorf("\nReceived: nil.\nShould be: an error.\n")
+	}
+}
@@ -0,0 +1,14 @@
+// The validatorcheck command reports the mistakes of the validator tags, it can be used alone or by go vet:
+//
+//	validatorcheck ./...
+//	go vet -vettool=$(which validatorcheck) ./...
+package main
+
+import (
+	"github.com/Wandecilenio01/validator/validatorcheck"
+	"golang.org/x/tools/go/analysis/singlechecker"
+)
+
+func main() {
+	singlechecker.Main(validatorcheck.Analyzer)
+}
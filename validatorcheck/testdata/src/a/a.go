@@ -0,0 +1,24 @@
+package a
+
+import "time"
+
+type Person struct {
+	Name     string    `struct-validator:"required|min:3"`
+	Age      int       `struct-validator:"min:18|email"`                // want `field Age: Error: Invalid tag "min:18\|email" at position 7: the rule email does not exist in the numeric validator`
+	Email    string    `struct-validator:"required_with:Phone|max:ten"` // want `field Email: Error: Invalid tag "required_with:Phone\|max:ten" at position 0: the rule required_with uses the field Phone, that does not exist` `field Email: .* the value of the rule max has to be an unsigned integer, not "ten"`
+	Zip      string    `json:"zip" struct-validator:"regex:^[0-9$"`     // want `field Zip: .* the value of the rule regex is not a valid regular expression`
+	CreateAt time.Time `struct-validator:"after:tomorrow"`              // want `field CreateAt: .* the value of the rule after has to be today or today\+N, not "tomorrow"`
+	Tags     []string  `struct-validator:"min:1|"`                      // want `field Tags: .* at position 6: expected a rule name`
+	Username string    `struct-validator:"username"`
+	Login    string    `struct-validator:"required|login"`
+	Mobile   string    `struct-validator:"contact"`
+	Level    int       `struct-validator:"login"` // want `field Level: .* the rule alpha_dash does not exist in the numeric validator`
+	internal string
+}
+
+type Signup struct {
+	Password string `struct-validator:"required" struct-validator-create:"min:8|emial"`                  // want `field Password: Error: Invalid tag "min:8\|emial" at position 6: the rule emial does not exist in the string validator`
+	Email    string `struct-validator:"required" struct-validator-msg:"required=Required|email=Invalid"` // want `field Email: Error: Invalid tag "required=Required\|email=Invalid" at position 18: the message of the rule email is not used, the field has no rule email`
+	Code     string `json:"code" struct-validator-update:"length:6" struct-validator-msg:"length=Six digits"`
+	Login    string `struct-validator:"login" struct-validator-msg:"alpha_dash=Only letters, numbers and dashes"`
+}
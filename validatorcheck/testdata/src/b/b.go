@@ -0,0 +1,6 @@
+package b
+
+type Account struct {
+	Login string `struct-validator:"login"`
+	Name  string `struct-validator:"username|max:ten"` // want `field Name: .* the value of the rule max has to be an unsigned integer, not "ten"`
+}
@@ -0,0 +1,174 @@
+// Package validatorcheck - go/analysis analyzer that reports the mistakes of the validator tags at build
+// time, like unknown rules, rule values that are not numbers, invalid regular expressions,
+// required_with* rules with fields that do not exist and messages of rules that the field does not have
+package validatorcheck
+
+import (
+	"go/ast"
+	"go/types"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/Wandecilenio01/validator"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+var (
+	// tagName - name of the tag checked, like validator.TagName
+	tagName string
+	// customRules - custom rules of the program, like "string.username,numeric.even"
+	customRules string
+	// customAliases - aliases of the program, like "username=alpha_dash|min:3,length_between=min:$1|max:$2"
+	customAliases string
+	// registerOnce - registers the custom rules and the aliases of the flags only once, before the first
+	// package is checked, because the passes of the packages run concurrently
+	registerOnce sync.Once
+	// registerError - error of the registration of the aliases
+	registerError error
+)
+
+// Analyzer - Analyzer of the validator tags, it can be used with "go vet -vettool" by the validatorcheck
+// command. The custom rules of the program are informed by the "rules" flag and the aliases by the
+// "aliases" flag, the other rules are unknown.
+var Analyzer = &analysis.Analyzer{
+	Name:     "validatorcheck",
+	Doc:      "check the rules of the struct-validator tags",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+func init() {
+	Analyzer.Flags.StringVar(&tagName, "tag", validator.TagName, "name of the validator tag")
+	Analyzer.Flags.StringVar(&customRules, "rules", "", "custom rules of the program, like string.username,numeric.even")
+	Analyzer.Flags.StringVar(&customAliases, "aliases", "", "aliases of the program, like username=alpha_dash|min:3,length_between=min:$1|max:$2")
+}
+
+// run - check the tags of the fields of all structs of the package
+func run(pass *analysis.Pass) (interface{}, error) {
+	registerOnce.Do(func() {
+		registerError = register(customRules, customAliases)
+	})
+	if registerError != nil {
+		return nil, registerError
+	}
+	pass.ResultOf[inspect.Analyzer].(*inspector.Inspector).Preorder([]ast.Node{(*ast.StructType)(nil)}, func(node ast.Node) {
+		structType, ok := pass.TypesInfo.TypeOf(node.(*ast.StructType)).(*types.Struct)
+		if !ok {
+			return
+		}
+		fieldsNames := make([]string, structType.NumFields())
+		for i := range fieldsNames {
+			fieldsNames[i] = structType.Field(i).Name()
+		}
+		i := 0
+		for _, field := range node.(*ast.StructType).Fields.List {
+			// fields like "A, B string" have one field of the ast and many fields of the type
+			names := len(field.Names)
+			if names == 0 {
+				names = 1
+			}
+			for ; names > 0; names-- {
+				checkField(pass, field, structType.Field(i), structType.Tag(i), fieldsNames)
+				i++
+			}
+		}
+	})
+	return nil, nil
+}
+
+// register - register the custom rules of the "rules" flag and the aliases of the "aliases" flag in the
+// validator, so the tags that use them are valid
+func register(rules string, aliases string) error {
+	for _, customRule := range strings.Split(rules, ",") {
+		if parts := strings.SplitN(strings.TrimSpace(customRule), ".", 2); len(parts) == 2 {
+			// the custom rules are known, but their values are not checked
+			validator.AddCustomValidator(parts[0], parts[1], func(validator.MessageInput) error { return nil })
+		}
+	}
+	for name, rules := range parseAliases(aliases) {
+		if err := validator.RegisterAlias(name, rules); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseAliases - returns the aliases of the "aliases" flag, the parts without "=" are parameters of the
+// rules of the previous alias, like "in:a,b"
+func parseAliases(value string) map[string]string {
+	aliases := make(map[string]string)
+	name := ""
+	for _, part := range strings.Split(value, ",") {
+		if parts := strings.SplitN(part, "=", 2); len(parts) == 2 {
+			name = strings.TrimSpace(parts[0])
+			aliases[name] = strings.TrimSpace(parts[1])
+		} else if name != "" {
+			aliases[name] += "," + strings.TrimSpace(part)
+		}
+	}
+	return aliases
+}
+
+// checkField - report the mistakes of the validator tag of a field, of the tags of the groups, like
+// "struct-validator-create", and of the messages tag, like "struct-validator-msg"
+func checkField(pass *analysis.Pass, field *ast.Field, variable *types.Var, tag string, fieldsNames []string) {
+	if field.Tag == nil {
+		return
+	}
+	rulesTags := []string{}
+	for _, name := range getTagsNames(tag) {
+		if name != tagName && (!strings.HasPrefix(name, tagName+"-") || name == tagName+"-msg") {
+			continue
+		}
+		rulesTag := reflect.StructTag(tag).Get(name)
+		rulesTags = append(rulesTags, rulesTag)
+		for _, err := range validator.CheckTag(getTypeName(variable.Type()), rulesTag, fieldsNames) {
+			pass.Reportf(field.Tag.Pos(), "field %s: %v", variable.Name(), err)
+		}
+	}
+	if messagesTag, ok := reflect.StructTag(tag).Lookup(tagName + "-msg"); ok {
+		for _, err := range validator.CheckMessagesTag(messagesTag, rulesTags) {
+			pass.Reportf(field.Tag.Pos(), "field %s: %v", variable.Name(), err)
+		}
+	}
+}
+
+// getTagsNames - returns the names of the tags of a field, like "json" and "struct-validator", in the
+// conventional format of reflect.StructTag
+func getTagsNames(tag string) (names []string) {
+	for tag != "" {
+		tag = strings.TrimLeft(tag, " ")
+		i := 0
+		for i < len(tag) && tag[i] > ' ' && tag[i] != ':' && tag[i] != '"' && tag[i] != 0x7f {
+			i++
+		}
+		if i == 0 || i+1 >= len(tag) || tag[i] != ':' || tag[i+1] != '"' {
+			return names
+		}
+		names = append(names, tag[:i])
+		// skip the quoted value
+		tag = tag[i+1:]
+		i = 1
+		for i < len(tag) && tag[i] != '"' {
+			if tag[i] == '\\' {
+				i++
+			}
+			i++
+		}
+		if i >= len(tag) {
+			return names
+		}
+		tag = tag[i+1:]
+	}
+	return names
+}
+
+// getTypeName - returns the name of the type like the String method of reflect.Type, like "time.Time"
+func getTypeName(fieldType types.Type) string {
+	return types.TypeString(fieldType, func(pkg *types.Package) string {
+		return pkg.Name()
+	})
+}
@@ -0,0 +1,14 @@
+package validatorcheck
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzer(t *testing.T) {
+	t.Log("\nIt tests the mistakes reported in the tags of the testdata\n")
+	Analyzer.Flags.Set("rules", "string.username")
+	Analyzer.Flags.Set("aliases", "login=alpha_dash|min:3,contact=required_with:Login,Name")
+	analysistest.Run(t, analysistest.TestData(), Analyzer, "a", "b")
+}
@@ -0,0 +1,99 @@
+package validator
+
+import (
+	"database/sql/driver"
+	"reflect"
+	"time"
+)
+
+var (
+	// valuerType - type of the driver.Valuer interface, implemented by the sql.Null* types
+	valuerType = reflect.TypeOf((*driver.Valuer)(nil)).Elem()
+)
+
+// isValuerType - check if the type, or a pointer to the type, implements driver.Valuer
+func isValuerType(fieldType reflect.Type) bool {
+	for fieldType.Kind() == reflect.Ptr {
+		fieldType = fieldType.Elem()
+	}
+	return fieldType.Implements(valuerType) || reflect.PtrTo(fieldType).Implements(valuerType)
+}
+
+// getValuer - returns the driver.Valuer of the field, the nil pointers are the zero value of their type
+func getValuer(field reflect.Value) (driver.Valuer, bool) {
+	valuer, ok := getImplementation(getPointedValue(field), valuerType)
+	if !ok {
+		return nil, false
+	}
+	return valuer.(driver.Valuer), true
+}
+
+// getValuerValue - returns the value of the driver.Valuer used by the rules and its 'validator key type',
+// like the value of a valid sql.NullString as a string. The null values, like an invalid sql.NullInt64,
+// and the values that cannot be read are the zero value of the validatorKeyType of the field.
+func getValuerValue(field reflect.Value, validatorKeyType string) (interface{}, string) {
+	valuer, ok := getValuer(field)
+	if !ok {
+		return nil, ""
+	}
+	value, err := valuer.Value()
+	if err != nil || value == nil {
+		// null values
+		return getZeroValue(validatorKeyType), validatorKeyType
+	}
+	switch value := value.(type) {
+	case int64:
+		return float64(value), "numeric"
+	case float64:
+		return value, "numeric"
+	case string:
+		return value, "string"
+	case []byte:
+		return string(value), "string"
+	case time.Time:
+		return value, "timestamp"
+	}
+	// booleans have no rules
+	return value, ""
+}
+
+// validateValuer - returns the error of the Value method of the driver.Valuer field, with the validator
+// key type "valuer" and the rule "value"
+func validateValuer(field reflect.Value, messageInput MessageInput) (validationErrors ValidationErrors) {
+	valuer, ok := getValuer(field)
+	if !ok {
+		return nil
+	}
+	if _, err := valuer.Value(); err != nil {
+		messageInput.ValidatorKeyType, messageInput.RuleName, messageInput.RuleValue = "valuer", "value", ""
+		validationErrors = append(validationErrors, newFieldError(messageInput, err))
+	}
+	return validationErrors
+}
+
+// isNullValuer - check if the field is a driver.Valuer with a null value, like an invalid sql.NullString,
+// the values that cannot be read are not null
+func isNullValuer(field reflect.Value) bool {
+	if !isValuerType(field.Type()) {
+		return false
+	}
+	valuer, ok := getValuer(field)
+	if !ok {
+		return false
+	}
+	value, err := valuer.Value()
+	return err == nil && value == nil
+}
+
+// getZeroValue - returns the zero value used by the rules of the 'validator key type'
+func getZeroValue(validatorKeyType string) interface{} {
+	switch validatorKeyType {
+	case "numeric":
+		return float64(0)
+	case "string":
+		return ""
+	case "timestamp":
+		return time.Time{}
+	}
+	return nil
+}